@@ -0,0 +1,228 @@
+package de
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/dynamic"
+	"github.com/sineatos/deag/tools/support"
+)
+
+// DE is the differential evolution engine, the mutation strategy and the crossover scheme are pluggable.
+type DE struct {
+	population base.Individuals
+	mutation   Mutation
+	crossover  Crossover
+	f          float64
+	cr         float64
+	stat       support.Statistics
+	hof        support.HallOfFame
+	logbook    support.Logbook
+	size       int
+	maxFES     int
+	currentFES int
+	gen        int
+	maxGen     int
+	evaluator  benchmarks.Float64Evaluator
+	bounds     *bounds.Bounds
+	resample   int
+	dynamic    *dynamic.Handler
+}
+
+// NewDE returns *DE.
+// mutation is the mutation strategy, such as MutRand1.
+// crossover is the crossover scheme, such as CxBinomial.
+// f is scale factor.
+// cr is crossover rate.
+// maxGen is maximum running generation.
+// maxFES is maximum function evalutions, maxFES < 0 means maxFES=INF.
+// stat is Statistics, optional.
+// hof is HallOfFame records the best individuals, optional.
+// evaluator is a Float64Evaluator.
+func NewDE(mutation Mutation, crossover Crossover, f, cr float64, maxGen, maxFES int, stat support.Statistics, hof support.HallOfFame, evaluator benchmarks.Float64Evaluator) *DE {
+	return &DE{
+		mutation:  mutation,
+		crossover: crossover,
+		f:         f,
+		cr:        cr,
+		maxGen:    maxGen,
+		maxFES:    maxFES,
+		stat:      stat,
+		hof:       hof,
+		evaluator: evaluator,
+	}
+}
+
+// NewDEByName returns *DE whose mutation strategy and crossover scheme are selected by name, such as NewDEByName("rand/1", "bin", ...), see GetMutation and GetCrossover.
+func NewDEByName(mutation, crossover string, f, cr float64, maxGen, maxFES int, stat support.Statistics, hof support.HallOfFame, evaluator benchmarks.Float64Evaluator) *DE {
+	return NewDE(GetMutation(mutation), GetCrossover(crossover), f, cr, maxGen, maxFES, stat, hof, evaluator)
+}
+
+// SetBounds sets the bounds of the search space, the trial vectors out of the bounds are repaired with the target vectors as parents.
+// resample is the maximum times to generate the trial vector until it is in the bounds before repairing it (the resampling strategy), resample <= 1 means repairing directly.
+func (evol *DE) SetBounds(b *bounds.Bounds, resample int) {
+	evol.bounds = b
+	evol.resample = resample
+}
+
+// SetDynamic sets the Handler of the dynamic optimization, the changes are detected at the beginning of each generation.
+// When a change is detected, the responses of h are applied, then the population and the HallOfFame are re-evaluated.
+func (evol *DE) SetDynamic(h *dynamic.Handler) {
+	evol.dynamic = h
+}
+
+// Init initializes the population and prepared for some data
+func (evol *DE) Init(population base.Individuals) {
+	evol.currentFES = 0
+	evol.gen = 0
+	evol.logbook = support.NewDefaultLogbook(evol.maxGen, 0)
+	evol.population = population
+	evol.size = population.Len()
+
+	if evol.hof == nil {
+		evol.hof = support.NewDefaultHallOfFame(1, nil)
+	}
+
+	if !evol.IsTerminated() {
+		evol.evaluate(evol.population)
+		if evol.dynamic != nil {
+			evol.dynamic.Reset()
+			evol.dynamic.Watch(evol.population)
+		}
+		evol.log()
+	}
+}
+
+// IsTerminated returns if the evolution is terminated
+func (evol *DE) IsTerminated() (flag bool) {
+	flag = evol.gen >= evol.maxGen
+	flag = flag || (evol.maxFES > 0 && evol.currentFES+evol.size > evol.maxFES)
+	flag = flag || (evol.hof.Len() > 0 && evol.hof.Get(0).GetFitness().GetValues()[0] < 1E-14)
+	return
+}
+
+// Evolve runs the evol a time/generation per call and return generation time
+func (evol *DE) Evolve() interface{} {
+	evol.gen++
+	if !evol.IsTerminated() {
+		if evol.dynamic != nil {
+			evol.detectChange()
+		}
+		ranked := rankPopulation(evol.population)
+		offsprings := make(base.Individuals, evol.size)
+		for i, agent := range evol.population {
+			target := agent.GetChromosome().([]float64)
+			generate := func() []float64 {
+				donor := evol.mutation(i, evol.population, ranked, evol.f)
+				return evol.crossover(target, donor, evol.cr)
+			}
+			var trial []float64
+			if evol.bounds != nil {
+				trial = evol.bounds.Sample(generate, evol.resample, target)
+			} else {
+				trial = generate()
+			}
+			t := agent.Clone().(base.Individual)
+			t.SetChromosome(trial)
+			t.GetFitness().SetValues(evol.evaluator(toFloat64Individual(t)))
+			// selection
+			if t.GetFitness().Greater(agent.GetFitness()) {
+				offsprings[i] = t
+			} else {
+				offsprings[i] = agent.Clone().(base.Individual)
+			}
+		}
+		evol.population = offsprings
+		evol.currentFES += evol.size
+		// log
+		evol.log()
+	}
+	return evol.gen
+}
+
+// Run executes Evolve() until the terminal condition satisfied
+func (evol *DE) Run() {
+	for !evol.IsTerminated() {
+		evol.Evolve()
+	}
+}
+
+// GetLogbook returns the logbook saving data
+func (evol *DE) GetLogbook() support.Logbook {
+	return evol.logbook
+}
+
+// GetHallOfFame returns the HallOfFame saving best individuals
+func (evol *DE) GetHallOfFame() support.HallOfFame {
+	return evol.hof
+}
+
+// GetPopulation returns the current population
+func (evol *DE) GetPopulation() base.Individuals {
+	return evol.population
+}
+
+// detectChange re-evaluates the sentinels and responds to the change of the environment
+func (evol *DE) detectChange() {
+	evaluate := func(ind base.Individual) []float64 {
+		return evol.evaluator(toFloat64Individual(ind))
+	}
+	changed, fes := evol.dynamic.Detect(evaluate)
+	evol.currentFES += fes
+	if changed {
+		evol.dynamic.Respond(evol.population)
+		evol.currentFES += dynamic.Reevaluate(evol.population, evaluate)
+		evol.currentFES += dynamic.ReevaluateHallOfFame(evol.hof, evol.population, evaluate)
+		evol.dynamic.Watch(evol.population)
+	}
+}
+
+func (evol *DE) evaluate(individuals base.Individuals) {
+	for _, ind := range individuals {
+		fit := evol.evaluator(toFloat64Individual(ind))
+		ind.GetFitness().SetValues(fit)
+	}
+	evol.currentFES += individuals.Len()
+}
+
+func (evol *DE) log() {
+	var datas support.Dict
+	if evol.stat != nil {
+		datas = evol.stat.Compile(evol.population)
+	} else {
+		datas = make(support.Dict, 3)
+	}
+	datas[support.GEN] = evol.gen
+	datas[support.FES] = evol.size
+	if evol.dynamic != nil {
+		evol.dynamic.Record(datas)
+	}
+	evol.logbook.Record(datas)
+
+	evol.hof.Update(evol.population)
+}
+
+// rankPopulation returns a copy of population sorted from the best individual to the worst one
+func rankPopulation(population base.Individuals) base.Individuals {
+	ranked := make(base.Individuals, population.Len())
+	copy(ranked, population)
+	sort.Stable(sort.Reverse(ranked))
+	return ranked
+}
+
+// toFloat64Individual returns the *base.Float64Individual held by ind, which is used by benchmarks.Float64Evaluator
+func toFloat64Individual(ind base.Individual) *base.Float64Individual {
+	switch fInd := ind.(type) {
+	case *base.Float64Individual:
+		return fInd
+	case *base.Float64DEIndividual:
+		return &fInd.Float64Individual
+	case *base.Float64ESIndividual:
+		return &fInd.Float64Individual
+	default:
+		panic(fmt.Sprintf("Individual should hold a base.Float64Individual: %v", ind))
+	}
+}
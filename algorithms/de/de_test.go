@@ -0,0 +1,151 @@
+package de
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/support"
+)
+
+func TestDEByName(t *testing.T) {
+	f, cr := 0.5, 0.9
+	maxGen, maxFES := 100, math.MaxInt64
+	size, dims := 50, 5
+	low, up := -5.12, 5.12
+	names := []string{"rand/1", "rand/2", "best/1", "best/2", "current-to-best/1", "current-to-rand/1", "rand-to-best/2", "current-to-pbest/1"}
+	for _, mutation := range names {
+		for _, crossover := range []string{"bin", "exp"} {
+			st, hof := newDEBest1Statistics(), support.NewDefaultHallOfFame(1, nil)
+			pop := newDEBest1Population(size, dims, low, up)
+			evaluator := newDEBest1Constraint(low, up, benchmarks.Sphere)
+			evol := NewDEByName(mutation, crossover, f, cr, maxGen, maxFES, st, hof, evaluator)
+			evol.Init(pop)
+			first := hof.Get(0).GetFitness().GetValues()[0]
+			evol.Run()
+			last := hof.Get(0).GetFitness().GetValues()[0]
+			if last > first {
+				t.Errorf("%s/%s: the best fitness gets worse: %v -> %v", mutation, crossover, first, last)
+			}
+			t.Logf("%s/%s: %v -> %v", mutation, crossover, first, last)
+		}
+	}
+}
+
+func TestGetMutationUnknown(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("GetMutation doesn't panic with an unknown name")
+		}
+	}()
+	GetMutation("unknown/1")
+}
+
+func TestCxExponential(t *testing.T) {
+	target := []float64{0, 0, 0, 0, 0, 0, 0, 0}
+	donor := []float64{1, 1, 1, 1, 1, 1, 1, 1}
+	for n := 0; n < 100; n++ {
+		trial := CxExponential(target, donor, 0.5)
+		// the components taken from the donor must be consecutive (cyclically)
+		changes := 0
+		for j := range trial {
+			if trial[j] != trial[(j+1)%len(trial)] {
+				changes++
+			}
+		}
+		if changes > 2 {
+			t.Errorf("the components taken from donor aren't consecutive: %v", trial)
+		}
+		if s := sumFloat64(trial); s < 1 {
+			t.Errorf("no component is taken from donor: %v", trial)
+		}
+	}
+	if trial := CxExponential(target, donor, 1.0); sumFloat64(trial) != float64(len(donor)) {
+		t.Errorf("all components should be taken from donor when cr=1.0: %v", trial)
+	}
+	if trial := CxBinomial(target, donor, 0.0); sumFloat64(trial) != 1 {
+		t.Errorf("only one component should be taken from donor when cr=0.0: %v", trial)
+	}
+}
+
+func TestCxBinomial(t *testing.T) {
+	target := []float64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	donor := []float64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	if trial := CxBinomial(target, donor, 1.0); sumFloat64(trial) != float64(len(donor)) {
+		t.Errorf("all components should be taken from donor when cr=1.0: %v", trial)
+	}
+	// each component except the forced one is taken from the donor with probability cr
+	total, n, cr := 0.0, 2000, 0.2
+	for i := 0; i < n; i++ {
+		total += sumFloat64(CxBinomial(target, donor, cr))
+	}
+	expected := 1.0 + cr*float64(len(donor)-1)
+	if mean := total / float64(n); math.Abs(mean-expected) > 0.2 {
+		t.Errorf("the mean number of components taken from donor should be about %v: %v", expected, mean)
+	}
+}
+
+func TestMutCurrentToRand1(t *testing.T) {
+	// x_r2 = x_r3, so the donor is x_i + K(x_r1 - x_i) with x_i = 0
+	pop := base.Individuals{
+		base.NewFloat64Individual([]float64{0, 0, 0, 0}, base.NewFitness([]float64{-1.0})),
+		base.NewFloat64Individual([]float64{1, 2, 3, 4}, base.NewFitness([]float64{-1.0})),
+		base.NewFloat64Individual([]float64{1, 2, 3, 4}, base.NewFitness([]float64{-1.0})),
+		base.NewFloat64Individual([]float64{1, 2, 3, 4}, base.NewFitness([]float64{-1.0})),
+	}
+	for n := 0; n < 20; n++ {
+		donor := MutCurrentToRand1(0, pop, pop, 0.5)
+		k := donor[0]
+		for j, d := range donor {
+			if math.Abs(d-k*float64(j+1)) > 1e-12 {
+				t.Fatalf("one K should be used for all components: %v", donor)
+			}
+		}
+	}
+}
+
+func TestMutBest1(t *testing.T) {
+	// x_r1 = x_r2, so the donor is the best individual of ranked
+	pop := base.Individuals{
+		base.NewFloat64Individual([]float64{0, 0}, base.NewFitness([]float64{-1.0})),
+		base.NewFloat64Individual([]float64{1, 1}, base.NewFitness([]float64{-1.0})),
+		base.NewFloat64Individual([]float64{1, 1}, base.NewFitness([]float64{-1.0})),
+	}
+	best := base.NewFloat64Individual([]float64{3, 4}, base.NewFitness([]float64{-1.0}))
+	ranked := base.Individuals{best, pop[1], pop[2]}
+	if donor := MutBest1(0, pop, ranked, 0.5); donor[0] != 3 || donor[1] != 4 {
+		t.Errorf("the donor should be the best of the population: %v", donor)
+	}
+}
+
+func sumFloat64(values []float64) float64 {
+	ans := 0.0
+	for _, v := range values {
+		ans += v
+	}
+	return ans
+}
+
+func TestDEWithBounds(t *testing.T) {
+	f, cr := 0.9, 0.9
+	maxGen, maxFES := 50, math.MaxInt64
+	size, dims := 30, 5
+	low, up := -5.12, 5.12
+	handlers := []bounds.Handler{bounds.Clip, bounds.Reflect, bounds.Wrap, bounds.RandomReinit, bounds.MidpointToParent}
+	for _, resample := range []int{0, 10} {
+		b := bounds.NewBounds(low, up, handlers, dims)
+		pop := newDEBest1Population(size, dims, low, up)
+		evol := NewDEByName("rand/2", "bin", f, cr, maxGen, maxFES, nil, nil, benchmarks.Rastrigin)
+		evol.SetBounds(b, resample)
+		evol.Init(pop)
+		evol.Run()
+		for _, ind := range evol.GetPopulation() {
+			if !b.Contains(ind.GetChromosome().([]float64)) {
+				t.Errorf("individual is out of bounds (resample=%d): %v", resample, ind)
+			}
+		}
+		t.Log(evol.GetHallOfFame().Get(0))
+	}
+}
@@ -0,0 +1,48 @@
+package de
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/dynamic"
+	"github.com/sineatos/deag/tools/inits"
+)
+
+func TestDEDynamic(t *testing.T) {
+	size, dims, period := 20, 5, 500
+	sc := benchmarks.MovingPeaksScenario2()
+	sc.Period = period
+	mp := benchmarks.NewMovingPeaks(dims, sc)
+	// the evolution stops when the best value is less than 1E-14, so the values are shifted to be positive
+	evaluator := func(ind *base.Float64Individual) []float64 { return []float64{mp.Evaluate(ind)[0] + 1E4} }
+	b := bounds.NewBounds(sc.MinCoord, sc.MaxCoord, bounds.Clip, dims)
+	h := dynamic.NewHandler(2, dynamic.PartialReinit(0.2, b), dynamic.Memory(5))
+	h.SetErrors(mp.OfflineError, mp.CurrentError)
+	pop := make(base.Individuals, size)
+	limit := func() float64 { return sc.MinCoord + rand.Float64()*(sc.MaxCoord-sc.MinCoord) }
+	for i := range pop {
+		pop[i] = base.NewFloat64Individual(inits.GenerateFloat64SliceRepeat(limit, dims), base.NewFitness([]float64{1.0}))
+	}
+	evol := NewDE(MutRand1, CxBinomial, 0.5, 0.9, 200, -1, nil, nil, evaluator)
+	evol.SetBounds(b, 0)
+	evol.SetDynamic(h)
+	evol.Init(pop)
+	evol.Run()
+	// the last change may happen after the last detection
+	if changes := mp.GetNEvals() / period; h.GetChanges() != changes && h.GetChanges() != changes-1 {
+		t.Errorf("%v changes should be detected: %v", changes, h.GetChanges())
+	}
+	records := evol.GetLogbook().Select([]string{dynamic.OFFLINEERROR, dynamic.CHANGES})
+	last := records[len(records)-1]
+	if e, ok := last[0].(float64); !ok || math.IsNaN(e) || e < 0.0 {
+		t.Errorf("the offline error should be logged: %v", last)
+	}
+	if last[1] != h.GetChanges() {
+		t.Errorf("the number of changes should be logged: %v", last)
+	}
+	t.Logf("%v changes, offline error %v", h.GetChanges(), mp.OfflineError())
+}
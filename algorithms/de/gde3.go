@@ -0,0 +1,177 @@
+package de
+
+import (
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/emo"
+	"github.com/sineatos/deag/tools/support"
+)
+
+// GDE3 is the third version of generalized differential evolution for multi-objective problems [Kukkonen2005].
+// The trial vector replaces the target vector if it weakly dominates the target vector, it is discarded if the target vector dominates it,
+// otherwise both of them are kept. The population is pruned back to its size by the non-dominated sorting and crowding distance of NSGA-II.
+//
+// The mutation strategies using the best individuals (such as MutBest1) regard the population sorted by emo.SelNSGA2 as ranked.
+//
+// [Kukkonen2005] Kukkonen and Lampinen, "GDE3: The third evolution step of generalized differential evolution", 2005.
+type GDE3 struct {
+	population base.Individuals
+	mutation   Mutation
+	crossover  Crossover
+	f          float64
+	cr         float64
+	stat       support.Statistics
+	logbook    support.Logbook
+	size       int
+	maxFES     int
+	currentFES int
+	gen        int
+	maxGen     int
+	evaluator  benchmarks.Float64Evaluator
+	bounds     *bounds.Bounds
+	resample   int
+}
+
+// NewGDE3 returns *GDE3.
+// mutation is the mutation strategy, [Kukkonen2005] uses MutRand1.
+// crossover is the crossover scheme, [Kukkonen2005] uses CxBinomial.
+// f is scale factor.
+// cr is crossover rate.
+// maxGen is maximum running generation.
+// maxFES is maximum function evalutions, maxFES < 0 means maxFES=INF.
+// stat is Statistics, optional.
+// evaluator is a multi-objective Float64Evaluator.
+func NewGDE3(mutation Mutation, crossover Crossover, f, cr float64, maxGen, maxFES int, stat support.Statistics, evaluator benchmarks.Float64Evaluator) *GDE3 {
+	return &GDE3{
+		mutation:  mutation,
+		crossover: crossover,
+		f:         f,
+		cr:        cr,
+		maxGen:    maxGen,
+		maxFES:    maxFES,
+		stat:      stat,
+		evaluator: evaluator,
+	}
+}
+
+// SetBounds sets the bounds of the search space, the trial vectors out of the bounds are repaired with the target vectors as parents.
+// resample is the maximum times to generate the trial vector until it is in the bounds before repairing it (the resampling strategy), resample <= 1 means repairing directly.
+func (evol *GDE3) SetBounds(b *bounds.Bounds, resample int) {
+	evol.bounds = b
+	evol.resample = resample
+}
+
+// Init initializes the population and prepared for some data
+func (evol *GDE3) Init(population base.Individuals) {
+	evol.currentFES = 0
+	evol.gen = 0
+	evol.logbook = support.NewDefaultLogbook(evol.maxGen, 0)
+	evol.population = population
+	evol.size = population.Len()
+
+	if !evol.IsTerminated() {
+		for _, ind := range evol.population {
+			ind.GetFitness().SetValues(evol.evaluator(toFloat64Individual(ind)))
+		}
+		evol.currentFES += evol.size
+		evol.log()
+	}
+}
+
+// IsTerminated returns if the evolution is terminated
+func (evol *GDE3) IsTerminated() (flag bool) {
+	flag = evol.gen >= evol.maxGen
+	flag = flag || (evol.maxFES > 0 && evol.currentFES+evol.size > evol.maxFES)
+	return
+}
+
+// Evolve runs the evol a time/generation per call and return generation time
+func (evol *GDE3) Evolve() interface{} {
+	evol.gen++
+	if !evol.IsTerminated() {
+		ranked := emo.SelNSGA2(evol.population, evol.size)
+		offsprings := make(base.Individuals, 0, 2*evol.size)
+		for i, agent := range evol.population {
+			target := agent.GetChromosome().([]float64)
+			generate := func() []float64 {
+				donor := evol.mutation(i, evol.population, ranked, evol.f)
+				return evol.crossover(target, donor, evol.cr)
+			}
+			var trial []float64
+			if evol.bounds != nil {
+				trial = evol.bounds.Sample(generate, evol.resample, target)
+			} else {
+				trial = generate()
+			}
+			t := agent.Clone().(base.Individual)
+			t.SetChromosome(trial)
+			t.GetFitness().SetValues(evol.evaluator(toFloat64Individual(t)))
+			// selection
+			tFit, aFit := t.GetFitness(), agent.GetFitness()
+			if tFit.Dominates(aFit, nil) || tFit.Equal(aFit) {
+				offsprings = append(offsprings, t)
+			} else if aFit.Dominates(tFit, nil) {
+				offsprings = append(offsprings, agent.Clone().(base.Individual))
+			} else {
+				offsprings = append(offsprings, agent.Clone().(base.Individual), t)
+			}
+		}
+		// pruning
+		if offsprings.Len() > evol.size {
+			offsprings = emo.SelNSGA2(offsprings, evol.size)
+		}
+		evol.population = offsprings
+		evol.currentFES += evol.size
+		// log
+		evol.log()
+	}
+	return evol.gen
+}
+
+// Run executes Evolve() until the terminal condition satisfied
+func (evol *GDE3) Run() {
+	for !evol.IsTerminated() {
+		evol.Evolve()
+	}
+}
+
+// GetLogbook returns the logbook saving data
+func (evol *GDE3) GetLogbook() support.Logbook {
+	return evol.logbook
+}
+
+// GetPopulation returns the current population
+func (evol *GDE3) GetPopulation() base.Individuals {
+	return evol.population
+}
+
+// GetParetoFront returns the non-dominated individuals of the current population
+func (evol *GDE3) GetParetoFront() base.Individuals {
+	front := make(base.Individuals, 0, evol.size)
+	for _, ind := range evol.population {
+		dominated := false
+		for _, other := range evol.population {
+			if other.GetFitness().Dominates(ind.GetFitness(), nil) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			front = append(front, ind)
+		}
+	}
+	return front
+}
+
+func (evol *GDE3) log() {
+	var datas support.Dict
+	if evol.stat != nil {
+		datas = evol.stat.Compile(evol.population)
+	} else {
+		datas = make(support.Dict, 3)
+	}
+	datas[support.GEN] = evol.gen
+	datas[support.FES] = evol.size
+	evol.logbook.Record(datas)
+}
@@ -0,0 +1,87 @@
+package de
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/inits"
+	"github.com/sineatos/deag/tools/support"
+)
+
+func newGDE3Statistics() support.Statistics {
+	mStat := support.NewDefaultMultiStatistics("MultiStatistics")
+	indStat := support.NewStatisticsBasedOnMOFitness("")
+	indStat.Register("min", support.StatMOFitnessMin)
+	indStat.Register("max", support.StatMOFitnessMax)
+	mStat.AddStats(indStat)
+	return mStat
+}
+
+func newGDE3Population(size, dims, nobj int, low, up float64) base.Individuals {
+	pop := make(base.Individuals, size)
+	weights := make([]float64, nobj)
+	for i := range weights {
+		weights[i] = -1.0
+	}
+	fit := base.NewFitness(weights)
+	limit := func() float64 {
+		return low + rand.Float64()*(up-low)
+	}
+	for i := range pop {
+		pop[i] = base.NewFloat64Individual(inits.GenerateFloat64SliceRepeat(limit, dims), fit.Clone())
+	}
+	return pop
+}
+
+func TestGDE3(t *testing.T) {
+	f, cr := 0.5, 0.1
+	maxGen, maxFES := 200, math.MaxInt64
+	size, low, up := 50, 0.0, 1.0
+	problems := map[string]struct {
+		dims, nobj int
+		evaluator  benchmarks.Float64Evaluator
+		// distance returns the distance of the objective vector to the Pareto front
+		distance func(values []float64) float64
+	}{
+		"ZDT1": {30, 2, benchmarks.ZDT1, func(values []float64) float64 {
+			return values[1] - (1.0 - math.Sqrt(values[0]))
+		}},
+		"DTLZ2": {12, 3, func(ind *base.Float64Individual) []float64 {
+			return benchmarks.DTLZ2(ind, 3)
+		}, func(values []float64) float64 {
+			norm := 0.0
+			for _, v := range values {
+				norm += v * v
+			}
+			return math.Sqrt(norm) - 1.0
+		}},
+	}
+	for name, p := range problems {
+		b := bounds.NewBounds(low, up, bounds.Clip, p.dims)
+		pop := newGDE3Population(size, p.dims, p.nobj, low, up)
+		evol := NewGDE3(MutRand1, CxBinomial, f, cr, maxGen, maxFES, newGDE3Statistics(), p.evaluator)
+		evol.SetBounds(b, 0)
+		evol.Init(pop)
+		evol.Run()
+		if evol.GetPopulation().Len() != size {
+			t.Errorf("%s: the size of population is changed: %v", name, evol.GetPopulation().Len())
+		}
+		front := evol.GetParetoFront()
+		gd := 0.0
+		for _, ind := range front {
+			if !b.Contains(ind.GetChromosome().([]float64)) {
+				t.Errorf("%s: individual is out of bounds: %v", name, ind)
+			}
+			gd += p.distance(ind.GetFitness().GetValues())
+		}
+		gd /= float64(front.Len())
+		if gd > 0.1 {
+			t.Errorf("%s: the population is far from the Pareto front: %v", name, gd)
+		}
+		t.Logf("%s: %v non-dominated individuals, distance to the Pareto front %v", name, front.Len(), gd)
+	}
+}
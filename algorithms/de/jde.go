@@ -0,0 +1,208 @@
+package de
+
+import (
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/support"
+)
+
+// JDE is implement of self-adaptive differential evolution (jDE) proposed by Brest et al. [Brest2006].
+// Each individual carries its own scale factor and crossover rate (base.Float64DEIndividual),
+// which are reset randomly with small probabilities before creating the trial vector and survive only if the trial vector is selected.
+//
+// [Brest2006] Brest, Greiner, Boskovic, Mernik and Zumer, "Self-Adapting Control Parameters in Differential Evolution: A Comparative Study on Numerical Benchmark Problems", 2006.
+type JDE struct {
+	population base.Individuals
+	fl         float64
+	fu         float64
+	tau1       float64
+	tau2       float64
+	stat       support.Statistics
+	hof        support.HallOfFame
+	logbook    support.Logbook
+	size       int
+	maxFES     int
+	currentFES int
+	gen        int
+	maxGen     int
+	evaluator  benchmarks.Float64Evaluator
+	bounds     *bounds.Bounds
+	resample   int
+}
+
+// NewJDE returns *JDE.
+// fl and fu are the lower bound and the range of the new scale factor, F is reset to fl+rand*fu, typical values are 0.1 and 0.9.
+// tau1 and tau2 are the probabilities to reset F and CR, typical values are both 0.1.
+// maxGen is maximum running generation.
+// maxFES is maximum function evalutions, maxFES < 0 means maxFES=INF.
+// stat is Statistics, optional, the Statistics returned by NewStatisticsBasedOnF and NewStatisticsBasedOnCR can be added to log the distributions of F and CR.
+// hof is HallOfFame records the best individuals, optional.
+// evaluator is a Float64Evaluator.
+//
+// The individuals of population must be *base.Float64DEIndividual.
+func NewJDE(fl, fu, tau1, tau2 float64, maxGen, maxFES int, stat support.Statistics, hof support.HallOfFame, evaluator benchmarks.Float64Evaluator) *JDE {
+	return &JDE{
+		fl:        fl,
+		fu:        fu,
+		tau1:      tau1,
+		tau2:      tau2,
+		maxGen:    maxGen,
+		maxFES:    maxFES,
+		stat:      stat,
+		hof:       hof,
+		evaluator: evaluator,
+	}
+}
+
+// SetBounds sets the bounds of the search space, see DE.SetBounds
+func (evol *JDE) SetBounds(b *bounds.Bounds, resample int) {
+	evol.bounds = b
+	evol.resample = resample
+}
+
+// Init initializes the population and prepared for some data
+func (evol *JDE) Init(population base.Individuals) {
+	evol.currentFES = 0
+	evol.gen = 0
+	evol.logbook = support.NewDefaultLogbook(evol.maxGen, 0)
+	evol.population = population
+	evol.size = population.Len()
+
+	if evol.hof == nil {
+		evol.hof = support.NewDefaultHallOfFame(1, nil)
+	}
+
+	if !evol.IsTerminated() {
+		evol.evaluate(evol.population)
+		evol.log()
+	}
+}
+
+// IsTerminated returns if the evolution is terminated
+func (evol *JDE) IsTerminated() (flag bool) {
+	flag = evol.gen >= evol.maxGen
+	flag = flag || (evol.maxFES > 0 && evol.currentFES+evol.size > evol.maxFES)
+	flag = flag || (evol.hof.Len() > 0 && evol.hof.Get(0).GetFitness().GetValues()[0] < 1E-14)
+	return
+}
+
+// Evolve runs the evol a time/generation per call and return generation time
+func (evol *JDE) Evolve() interface{} {
+	evol.gen++
+	if !evol.IsTerminated() {
+		offsprings := make(base.Individuals, evol.size)
+		for i, ind := range evol.population {
+			agent := ind.(*base.Float64DEIndividual)
+			t := agent.Clone().(*base.Float64DEIndividual)
+			// adapt control parameters
+			if rand.Float64() < evol.tau1 {
+				t.SetF(evol.fl + rand.Float64()*evol.fu)
+			}
+			if rand.Float64() < evol.tau2 {
+				t.SetCR(rand.Float64())
+			}
+			// DE/rand/1/bin with the control parameters of the trial
+			target := agent.GetChromosome().([]float64)
+			generate := func() []float64 {
+				donor := MutRand1(i, evol.population, nil, t.GetF())
+				return CxBinomial(target, donor, t.GetCR())
+			}
+			if evol.bounds != nil {
+				t.SetChromosome(evol.bounds.Sample(generate, evol.resample, target))
+			} else {
+				t.SetChromosome(generate())
+			}
+			t.GetFitness().SetValues(evol.evaluator(toFloat64Individual(t)))
+			// selection, the trial inherits the new control parameters if it survives
+			if t.GetFitness().GreaterEqual(agent.GetFitness()) {
+				offsprings[i] = t
+			} else {
+				offsprings[i] = agent.Clone().(base.Individual)
+			}
+		}
+		evol.population = offsprings
+		evol.currentFES += evol.size
+		// log
+		evol.log()
+	}
+	return evol.gen
+}
+
+// Run executes Evolve() until the terminal condition satisfied
+func (evol *JDE) Run() {
+	for !evol.IsTerminated() {
+		evol.Evolve()
+	}
+}
+
+// GetLogbook returns the logbook saving data
+func (evol *JDE) GetLogbook() support.Logbook {
+	return evol.logbook
+}
+
+// GetHallOfFame returns the HallOfFame saving best individuals
+func (evol *JDE) GetHallOfFame() support.HallOfFame {
+	return evol.hof
+}
+
+// GetPopulation returns the current population
+func (evol *JDE) GetPopulation() base.Individuals {
+	return evol.population
+}
+
+func (evol *JDE) evaluate(individuals base.Individuals) {
+	for _, ind := range individuals {
+		fit := evol.evaluator(toFloat64Individual(ind))
+		ind.GetFitness().SetValues(fit)
+	}
+	evol.currentFES += individuals.Len()
+}
+
+func (evol *JDE) log() {
+	var datas support.Dict
+	if evol.stat != nil {
+		datas = evol.stat.Compile(evol.population)
+	} else {
+		datas = make(support.Dict, 3)
+	}
+	datas[support.GEN] = evol.gen
+	datas[support.FES] = evol.size
+	evol.logbook.Record(datas)
+
+	evol.hof.Update(evol.population)
+}
+
+// NewStatisticsBasedOnF returns a Statistics based on the scale factors of base.DEIndividual with name, if name is "", the name default is "F".
+// The converted data is []float64, so that the StatFitness* functions of support can be registered.
+func NewStatisticsBasedOnF(name string) support.Statistics {
+	if name == "" {
+		name = "F"
+	}
+	return support.NewDefaultStatistics(name, func(input interface{}) interface{} {
+		inds := input.(base.Individuals)
+		fs := make([]float64, inds.Len())
+		for i, ind := range inds {
+			fs[i] = ind.(base.DEIndividual).GetF()
+		}
+		return fs
+	})
+}
+
+// NewStatisticsBasedOnCR returns a Statistics based on the crossover rates of base.DEIndividual with name, if name is "", the name default is "CR".
+// The converted data is []float64, so that the StatFitness* functions of support can be registered.
+func NewStatisticsBasedOnCR(name string) support.Statistics {
+	if name == "" {
+		name = "CR"
+	}
+	return support.NewDefaultStatistics(name, func(input interface{}) interface{} {
+		inds := input.(base.Individuals)
+		crs := make([]float64, inds.Len())
+		for i, ind := range inds {
+			crs[i] = ind.(base.DEIndividual).GetCR()
+		}
+		return crs
+	})
+}
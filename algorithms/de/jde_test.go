@@ -0,0 +1,73 @@
+package de
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/inits"
+	"github.com/sineatos/deag/tools/support"
+)
+
+func newJDEStatistics() support.Statistics {
+	mStat := support.NewDefaultMultiStatistics("MultiStatistics")
+	indStat := support.NewStatisticsBasedOnFitness("fitness")
+	fStat := NewStatisticsBasedOnF("")
+	crStat := NewStatisticsBasedOnCR("")
+	mStat.AddStats(indStat)
+	mStat.AddStats(fStat)
+	mStat.AddStats(crStat)
+	mStat.Register("min", support.StatFitnessMin)
+	mStat.Register("max", support.StatFitnessMax)
+	mStat.Register("avg", support.StatFitnessAvg)
+	mStat.Register("std", support.StatFitnessStd)
+	return mStat
+}
+
+func newJDEPopulation(size, dims int, low, up, f, cr float64) base.Individuals {
+	pop := make(base.Individuals, size)
+	fit := base.NewFitness([]float64{-1.0})
+	limit := func() float64 {
+		return low + rand.Float64()*(up-low)
+	}
+	getData := func() []float64 { return inits.GenerateFloat64SliceRepeat(limit, dims) }
+	for i := range pop {
+		pop[i] = base.NewFloat64DEIndividual(getData(), f, cr, fit.Clone())
+	}
+	return pop
+}
+
+func TestJDE(t *testing.T) {
+	fl, fu, tau1, tau2 := 0.1, 0.9, 0.1, 0.1
+	maxGen, maxFES := 200, math.MaxInt64
+	st, hof := newJDEStatistics(), support.NewDefaultHallOfFame(3, nil)
+	size, dims := 100, 5
+	low, up := -5.12, 5.12
+	pop := newJDEPopulation(size, dims, low, up, 0.5, 0.9)
+	evaluator := newDEBest1Constraint(low, up, benchmarks.Rastrigin)
+	evol := NewJDE(fl, fu, tau1, tau2, maxGen, maxFES, st, hof, evaluator)
+	evol.Init(pop)
+	evol.Run()
+	logbook := evol.GetLogbook()
+	if hof != evol.GetHallOfFame() {
+		t.Error("hof != evol.GetHallOfFame()")
+	}
+	for _, ind := range evol.GetPopulation() {
+		dInd := ind.(*base.Float64DEIndividual)
+		if f := dInd.GetF(); f < fl || f > fl+fu {
+			t.Errorf("F is out of range [%v, %v]: %v", fl, fl+fu, dInd)
+		}
+		if cr := dInd.GetCR(); cr < 0.0 || cr > 1.0 {
+			t.Errorf("CR is out of range [0, 1]: %v", dInd)
+		}
+	}
+	if logbook.GetChapter("F") == nil || logbook.GetChapter("CR") == nil {
+		t.Error("the distributions of F and CR are not logged")
+	}
+	t.Log("\n" + logbook.String())
+	for i := 0; i < hof.Len(); i++ {
+		t.Log(hof.Get(i))
+	}
+}
@@ -0,0 +1,222 @@
+package de
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+)
+
+// Mutation creates the donor vector of the target individual population[i].
+//
+// ranked is the population sorted from the best individual to the worst one, f is the scale factor.
+type Mutation func(i int, population, ranked base.Individuals, f float64) []float64
+
+// Crossover mixes the target vector and the donor vector and returns the trial vector, cr is the crossover rate.
+type Crossover func(target, donor []float64, cr float64) []float64
+
+var (
+	mutations = map[string]Mutation{
+		"rand/1":             MutRand1,
+		"rand/2":             MutRand2,
+		"best/1":             MutBest1,
+		"best/2":             MutBest2,
+		"current-to-best/1":  MutCurrentToBest1,
+		"current-to-rand/1":  MutCurrentToRand1,
+		"rand-to-best/2":     MutRandToBest2,
+		"current-to-pbest/1": MutCurrentToPBest1(0.05),
+	}
+	crossovers = map[string]Crossover{
+		"bin": CxBinomial,
+		"exp": CxExponential,
+	}
+)
+
+// RegisterMutation registers the mutation strategy with name, the registered strategy can be selected by NewDEByName
+func RegisterMutation(name string, mutation Mutation) {
+	mutations[name] = mutation
+}
+
+// GetMutation returns the mutation strategy registered with name.
+//
+// The built-in strategies are "rand/1", "rand/2", "best/1", "best/2", "current-to-best/1", "current-to-rand/1", "rand-to-best/2" and "current-to-pbest/1" (p = 0.05).
+func GetMutation(name string) Mutation {
+	mutation, ok := mutations[name]
+	if !ok {
+		panic(fmt.Sprintf("Unknown DE mutation strategy: %s", name))
+	}
+	return mutation
+}
+
+// RegisterCrossover registers the crossover scheme with name, the registered scheme can be selected by NewDEByName
+func RegisterCrossover(name string, crossover Crossover) {
+	crossovers[name] = crossover
+}
+
+// GetCrossover returns the crossover scheme registered with name.
+//
+// The built-in schemes are "bin" (binomial) and "exp" (exponential).
+func GetCrossover(name string) Crossover {
+	crossover, ok := crossovers[name]
+	if !ok {
+		panic(fmt.Sprintf("Unknown DE crossover scheme: %s", name))
+	}
+	return crossover
+}
+
+/***************************
+ * Mutation Strategies     *
+ ***************************/
+
+// MutRand1 is DE/rand/1: v = x_r1 + F(x_r2 - x_r3)
+func MutRand1(i int, population, ranked base.Individuals, f float64) []float64 {
+	r := pickChromosomes(i, population, 3)
+	donor := make([]float64, len(r[0]))
+	for j := range donor {
+		donor[j] = r[0][j] + f*(r[1][j]-r[2][j])
+	}
+	return donor
+}
+
+// MutRand2 is DE/rand/2: v = x_r1 + F(x_r2 - x_r3) + F(x_r4 - x_r5)
+func MutRand2(i int, population, ranked base.Individuals, f float64) []float64 {
+	r := pickChromosomes(i, population, 5)
+	donor := make([]float64, len(r[0]))
+	for j := range donor {
+		donor[j] = r[0][j] + f*(r[1][j]-r[2][j]) + f*(r[3][j]-r[4][j])
+	}
+	return donor
+}
+
+// MutBest1 is DE/best/1: v = x_best + F(x_r1 - x_r2)
+func MutBest1(i int, population, ranked base.Individuals, f float64) []float64 {
+	r := pickChromosomes(i, population, 2)
+	best := ranked[0].GetChromosome().([]float64)
+	donor := make([]float64, len(best))
+	for j := range donor {
+		donor[j] = best[j] + f*(r[0][j]-r[1][j])
+	}
+	return donor
+}
+
+// MutBest2 is DE/best/2: v = x_best + F(x_r1 - x_r2) + F(x_r3 - x_r4)
+func MutBest2(i int, population, ranked base.Individuals, f float64) []float64 {
+	r := pickChromosomes(i, population, 4)
+	best := ranked[0].GetChromosome().([]float64)
+	donor := make([]float64, len(best))
+	for j := range donor {
+		donor[j] = best[j] + f*(r[0][j]-r[1][j]) + f*(r[2][j]-r[3][j])
+	}
+	return donor
+}
+
+// MutCurrentToBest1 is DE/current-to-best/1: v = x_i + F(x_best - x_i) + F(x_r1 - x_r2)
+func MutCurrentToBest1(i int, population, ranked base.Individuals, f float64) []float64 {
+	r := pickChromosomes(i, population, 2)
+	current := population[i].GetChromosome().([]float64)
+	best := ranked[0].GetChromosome().([]float64)
+	donor := make([]float64, len(current))
+	for j := range donor {
+		donor[j] = current[j] + f*(best[j]-current[j]) + f*(r[0][j]-r[1][j])
+	}
+	return donor
+}
+
+// MutCurrentToRand1 is DE/current-to-rand/1: v = x_i + K(x_r1 - x_i) + F(x_r2 - x_r3), K is drawn uniformly from [0, 1).
+//
+// This strategy is rotation invariant and is usually used without crossover, i.e. with a binomial crossover whose cr is 1.0.
+func MutCurrentToRand1(i int, population, ranked base.Individuals, f float64) []float64 {
+	r := pickChromosomes(i, population, 3)
+	current := population[i].GetChromosome().([]float64)
+	k := rand.Float64()
+	donor := make([]float64, len(current))
+	for j := range donor {
+		donor[j] = current[j] + k*(r[0][j]-current[j]) + f*(r[1][j]-r[2][j])
+	}
+	return donor
+}
+
+// MutRandToBest2 is DE/rand-to-best/2: v = x_r1 + F(x_best - x_r1) + F(x_r2 - x_r3) + F(x_r4 - x_r5)
+func MutRandToBest2(i int, population, ranked base.Individuals, f float64) []float64 {
+	r := pickChromosomes(i, population, 5)
+	best := ranked[0].GetChromosome().([]float64)
+	donor := make([]float64, len(best))
+	for j := range donor {
+		donor[j] = r[0][j] + f*(best[j]-r[0][j]) + f*(r[1][j]-r[2][j]) + f*(r[3][j]-r[4][j])
+	}
+	return donor
+}
+
+// MutCurrentToPBest1 returns DE/current-to-pbest/1 (without archive) proposed in JADE [Zhang2009]:
+// v = x_i + F(x_pbest - x_i) + F(x_r1 - x_r2), x_pbest is chosen randomly from the best 100p% individuals.
+//
+// p is in (0, 1], at least one individual is used as the candidate of x_pbest.
+//
+// [Zhang2009] Zhang and Sanderson, "JADE: Adaptive Differential Evolution With Optional External Archive", 2009.
+func MutCurrentToPBest1(p float64) Mutation {
+	return func(i int, population, ranked base.Individuals, f float64) []float64 {
+		r := pickChromosomes(i, population, 2)
+		current := population[i].GetChromosome().([]float64)
+		top := int(math.Max(1.0, math.Round(p*float64(ranked.Len()))))
+		pbest := ranked[rand.Intn(top)].GetChromosome().([]float64)
+		donor := make([]float64, len(current))
+		for j := range donor {
+			donor[j] = current[j] + f*(pbest[j]-current[j]) + f*(r[0][j]-r[1][j])
+		}
+		return donor
+	}
+}
+
+// pickChromosomes returns the chromosomes of n different individuals which are different from population[i]
+func pickChromosomes(i int, population base.Individuals, n int) [][]float64 {
+	if population.Len() <= n {
+		panic(fmt.Sprintf("The size of population must be greater than %d: %d", n, population.Len()))
+	}
+	chroms, loc := make([][]float64, n), 0
+	for _, x := range rand.Perm(population.Len()) {
+		if x != i {
+			chroms[loc] = population[x].GetChromosome().([]float64)
+			loc++
+			if loc == n {
+				break
+			}
+		}
+	}
+	return chroms
+}
+
+/***************************
+ * Crossover Schemes       *
+ ***************************/
+
+// CxBinomial is the binomial (uniform) crossover, each component of the trial vector is taken from the donor vector with probability cr,
+// and one randomly chosen component is always taken from the donor vector.
+func CxBinomial(target, donor []float64, cr float64) []float64 {
+	trial := make([]float64, len(target))
+	copy(trial, target)
+	index := rand.Intn(len(target))
+	for j, d := range donor {
+		if index == j || rand.Float64() < cr {
+			trial[j] = d
+		}
+	}
+	return trial
+}
+
+// CxExponential is the exponential (two-point modulo) crossover, a block of consecutive (cyclically) components starting from a random position is taken from the donor vector,
+// the length L of the block satisfies P(L >= l) = cr^(l-1).
+func CxExponential(target, donor []float64, cr float64) []float64 {
+	size := len(target)
+	trial := make([]float64, size)
+	copy(trial, target)
+	start := rand.Intn(size)
+	for l := 0; l < size; l++ {
+		j := (start + l) % size
+		trial[j] = donor[j]
+		if rand.Float64() >= cr {
+			break
+		}
+	}
+	return trial
+}
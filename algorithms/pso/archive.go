@@ -0,0 +1,201 @@
+package pso
+
+import (
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/emo"
+)
+
+// Archive is the external archive of MOPSO, it keeps the non-dominated solutions found so far and provides the leaders of the particles
+type Archive interface {
+	// Update inserts the clones of the individuals which are not dominated by the archive, removes the solutions dominated by them and truncates the archive
+	Update(individuals base.Individuals)
+	// SelectLeader returns a solution in the archive as the leader of a particle
+	SelectLeader() base.Individual
+	// Len returns the number of the solutions in the archive
+	Len() int
+	// Get returns the i-th solution in the archive
+	Get(i int) base.Individual
+	// GetMembers returns the solutions in the archive (not a copy)
+	GetMembers() base.Individuals
+}
+
+// nondominatedArchive keeps the mutually non-dominated solutions, the fitnesses of the solutions are different from each other
+type nondominatedArchive struct {
+	maxSize int
+	members base.Individuals
+}
+
+// Len returns the number of the solutions in the archive
+func (a *nondominatedArchive) Len() int {
+	return len(a.members)
+}
+
+// Get returns the i-th solution in the archive
+func (a *nondominatedArchive) Get(i int) base.Individual {
+	return a.members[i]
+}
+
+// GetMembers returns the solutions in the archive (not a copy)
+func (a *nondominatedArchive) GetMembers() base.Individuals {
+	return a.members
+}
+
+// add inserts the clones of the individuals which are not dominated by (or equal to) the solutions in the archive, and removes the solutions dominated by them
+func (a *nondominatedArchive) add(individuals base.Individuals) {
+	for _, ind := range individuals {
+		fit := ind.GetFitness()
+		accepted := true
+		for _, m := range a.members {
+			if m.GetFitness().Dominates(fit, nil) || m.GetFitness().Equal(fit) {
+				accepted = false
+				break
+			}
+		}
+		if !accepted {
+			continue
+		}
+		members := make(base.Individuals, 0, len(a.members)+1)
+		for _, m := range a.members {
+			if !fit.Dominates(m.GetFitness(), nil) {
+				members = append(members, m)
+			}
+		}
+		a.members = append(members, ind.Clone().(base.Individual))
+	}
+}
+
+// remove removes the i-th solution in the archive
+func (a *nondominatedArchive) remove(i int) {
+	a.members = append(a.members[:i], a.members[i+1:]...)
+}
+
+// CrowdingArchive truncates the archive by the crowding distance [Raquel2005].
+// The solution with the smallest crowding distance is removed until the size of archive is not greater than maxSize,
+// and the leader is selected by a binary tournament preferring the larger crowding distance.
+//
+// [Raquel2005] Raquel and Naval, "An effective use of crowding distance in multiobjective particle swarm optimization", 2005.
+type CrowdingArchive struct {
+	nondominatedArchive
+	distances []float64
+}
+
+// NewCrowdingArchive returns *CrowdingArchive, maxSize <= 0 means the archive is unbounded
+func NewCrowdingArchive(maxSize int) *CrowdingArchive {
+	return &CrowdingArchive{nondominatedArchive: nondominatedArchive{maxSize: maxSize}}
+}
+
+// Update inserts the non-dominated individuals and truncates the archive by the crowding distance
+func (a *CrowdingArchive) Update(individuals base.Individuals) {
+	a.add(individuals)
+	a.distances = emo.CrowdingDistance(a.members)
+	for a.maxSize > 0 && len(a.members) > a.maxSize {
+		crowded := 0
+		for i, d := range a.distances {
+			if d < a.distances[crowded] {
+				crowded = i
+			}
+		}
+		a.remove(crowded)
+		a.distances = emo.CrowdingDistance(a.members)
+	}
+}
+
+// SelectLeader returns the less crowded one of two solutions randomly chosen
+func (a *CrowdingArchive) SelectLeader() base.Individual {
+	i, j := rand.Intn(len(a.members)), rand.Intn(len(a.members))
+	if a.distances[j] > a.distances[i] {
+		i = j
+	}
+	return a.members[i]
+}
+
+// GridArchive is the adaptive grid of MOPSO [Coello2004].
+// The objective space covered by the archive is divided into divisions^M hypercubes, the bounds of the grid are recalculated whenever the archive is updated.
+// A random solution in the most crowded hypercube is removed until the size of archive is not greater than maxSize,
+// and the leader is a random solution in a hypercube selected by roulette wheel, where the hypercube containing n solutions has the fitness 10/n.
+//
+// [Coello2004] Coello, Pulido and Lechuga, "Handling multiple objectives with particle swarm optimization", 2004.
+type GridArchive struct {
+	nondominatedArchive
+	divisions int
+	cubes     map[int][]int
+}
+
+// NewGridArchive returns *GridArchive, maxSize <= 0 means the archive is unbounded, divisions is the number of divisions of each objective, the typical value is 30
+func NewGridArchive(maxSize, divisions int) *GridArchive {
+	return &GridArchive{
+		nondominatedArchive: nondominatedArchive{maxSize: maxSize},
+		divisions:           divisions,
+	}
+}
+
+// Update inserts the non-dominated individuals and truncates the archive by the grid
+func (a *GridArchive) Update(individuals base.Individuals) {
+	a.add(individuals)
+	a.locate()
+	for a.maxSize > 0 && len(a.members) > a.maxSize {
+		var crowded []int
+		for _, cube := range a.cubes {
+			if len(cube) > len(crowded) {
+				crowded = cube
+			}
+		}
+		a.remove(crowded[rand.Intn(len(crowded))])
+		a.locate()
+	}
+}
+
+// SelectLeader returns a random solution in the hypercube selected by roulette wheel
+func (a *GridArchive) SelectLeader() base.Individual {
+	total := 0.0
+	for _, cube := range a.cubes {
+		total += 10.0 / float64(len(cube))
+	}
+	r := rand.Float64() * total
+	var chosen []int
+	for _, cube := range a.cubes {
+		chosen = cube
+		r -= 10.0 / float64(len(cube))
+		if r <= 0.0 {
+			break
+		}
+	}
+	return a.members[chosen[rand.Intn(len(chosen))]]
+}
+
+// locate recalculates the bounds of the grid and puts the solutions into the hypercubes
+func (a *GridArchive) locate() {
+	a.cubes = make(map[int][]int)
+	if len(a.members) == 0 {
+		return
+	}
+	nobj := a.members[0].GetFitness().Len()
+	low, up := make([]float64, nobj), make([]float64, nobj)
+	copy(low, a.members[0].GetFitness().GetValues())
+	copy(up, a.members[0].GetFitness().GetValues())
+	for _, m := range a.members[1:] {
+		for k, v := range m.GetFitness().GetValues() {
+			if v < low[k] {
+				low[k] = v
+			} else if v > up[k] {
+				up[k] = v
+			}
+		}
+	}
+	for i, m := range a.members {
+		key := 0
+		for k, v := range m.GetFitness().GetValues() {
+			d := 0
+			if up[k] > low[k] {
+				d = int((v - low[k]) / (up[k] - low[k]) * float64(a.divisions))
+				if d >= a.divisions {
+					d = a.divisions - 1
+				}
+			}
+			key = key*a.divisions + d
+		}
+		a.cubes[key] = append(a.cubes[key], i)
+	}
+}
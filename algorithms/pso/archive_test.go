@@ -0,0 +1,50 @@
+package pso
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+)
+
+func newArchiveIndividuals(size int) base.Individuals {
+	inds := make(base.Individuals, size)
+	fit := base.NewFitness([]float64{-1.0, -1.0})
+	for i := range inds {
+		cFit := fit.Clone()
+		cFit.SetValues([]float64{rand.Float64(), rand.Float64()})
+		inds[i] = base.NewFloat64Individual([]float64{}, cFit)
+	}
+	return inds
+}
+
+func TestArchives(t *testing.T) {
+	maxSize := 10
+	archives := map[string]Archive{
+		"crowding": NewCrowdingArchive(maxSize),
+		"grid":     NewGridArchive(maxSize, 5),
+	}
+	for name, archive := range archives {
+		for n := 0; n < 20; n++ {
+			archive.Update(newArchiveIndividuals(50))
+			if archive.Len() > maxSize || archive.Len() == 0 {
+				t.Errorf("%s: the size of archive is wrong: %v", name, archive.Len())
+			}
+			for i, a := range archive.GetMembers() {
+				for j, b := range archive.GetMembers() {
+					if i != j && (a.GetFitness().Dominates(b.GetFitness(), nil) || a.GetFitness().Equal(b.GetFitness())) {
+						t.Errorf("%s: %v dominates %v", name, a, b)
+					}
+				}
+			}
+			leader := archive.SelectLeader()
+			found := false
+			for _, m := range archive.GetMembers() {
+				found = found || m == leader
+			}
+			if !found {
+				t.Errorf("%s: leader is not in the archive: %v", name, leader)
+			}
+		}
+	}
+}
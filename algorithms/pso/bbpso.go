@@ -0,0 +1,115 @@
+package pso
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/support"
+)
+
+// BareBones is the bare-bones particle swarm optimization [Kennedy2003], which has no velocity.
+// Each component of the new position is sampled from the gaussian distribution N((pbest+nbest)/2, |pbest-nbest|),
+// where nbest is the best pbest in the neighbourhood of the particle.
+//
+// In the exploiting version (BBExp), each component is set to the component of pbest with probability 0.5.
+//
+// The speed of Particle is not used.
+//
+// [Kennedy2003] Kennedy, "Bare bones particle swarms", 2003.
+type BareBones struct {
+	swarm
+	exploit   bool
+	topology  Topology
+	evaluator benchmarks.Float64Evaluator
+	bounds    *bounds.Bounds
+}
+
+// NewBareBones returns *BareBones.
+// exploit indicates whether using the exploiting version (BBExp).
+// topology is the Topology defining the neighbourhood of each particle, NewGlobalTopology() gives the original bare-bones PSO.
+// maxGen is maximum running generation.
+// maxFES is maximum function evalutions, maxFES < 0 means maxFES=INF.
+// stat is Statistics, optional.
+// hof is HallOfFame records the best individuals, optional.
+// evaluator is a Float64Evaluator.
+func NewBareBones(exploit bool, topology Topology, maxGen, maxFES int, stat support.Statistics, hof support.HallOfFame, evaluator benchmarks.Float64Evaluator) *BareBones {
+	return &BareBones{
+		swarm:     swarm{maxGen: maxGen, maxFES: maxFES, stat: stat, hof: hof},
+		exploit:   exploit,
+		topology:  topology,
+		evaluator: evaluator,
+	}
+}
+
+// SetBounds sets the bounds of the search space, the positions out of the bounds are repaired with the previous positions as parents
+func (evol *BareBones) SetBounds(b *bounds.Bounds) {
+	evol.bounds = b
+}
+
+// Init initializes the population and prepared for some data
+func (evol *BareBones) Init(population base.Individuals) {
+	evol.reset(population)
+	if !evol.IsTerminated() {
+		evaluateParticles(evol.population, evol.evaluator)
+		evol.currentFES += evol.size
+		evol.log()
+	}
+}
+
+// Evolve runs the evol a time/generation per call and return generation time
+func (evol *BareBones) Evolve() interface{} {
+	evol.gen++
+	if !evol.IsTerminated() {
+		offsprings := make(base.Individuals, evol.size)
+		nbests := neighbourhoodBests(evol.population, evol.topology, particlePBest)
+		for i, ind := range evol.population {
+			cInd := ind.Clone().(*Particle)
+			chrom := cInd.GetChromosome().([]float64)
+			pChrom := cInd.GetPBest().GetChromosome().([]float64)
+			nChrom := nbests[i].GetChromosome().([]float64)
+			for j := range chrom {
+				if evol.exploit && rand.Float64() < 0.5 {
+					chrom[j] = pChrom[j]
+				} else {
+					mu, sigma := (pChrom[j]+nChrom[j])/2.0, math.Abs(pChrom[j]-nChrom[j])
+					chrom[j] = mu + sigma*rand.NormFloat64()
+				}
+			}
+			if evol.bounds != nil {
+				evol.bounds.Repair(chrom, ind.GetChromosome().([]float64))
+			}
+			cInd.GetFitness().SetValues(evol.evaluator(&cInd.Float64Individual))
+			if cInd.GetFitness().Greater(cInd.GetPBest().GetFitness()) {
+				cInd.SetPBest(cInd)
+			}
+			offsprings[i] = cInd
+		}
+		evol.population = offsprings
+		evol.currentFES += evol.size
+		// log
+		best := evol.hof.Get(0).GetFitness().Clone()
+		evol.log()
+		evol.topology.Update(evol.size, evol.hof.Get(0).GetFitness().Greater(best))
+	}
+	return evol.gen
+}
+
+// Run executes Evolve() until the terminal condition satisfied
+func (evol *BareBones) Run() {
+	for !evol.IsTerminated() {
+		evol.Evolve()
+	}
+}
+
+// evaluateParticles evaluates the particles and sets themselves as their pbests
+func evaluateParticles(individuals base.Individuals, evaluator benchmarks.Float64Evaluator) {
+	for _, ind := range individuals {
+		fInd := ind.(*Particle)
+		fit := evaluator(&fInd.Float64Individual)
+		fInd.GetFitness().SetValues(fit)
+		fInd.SetPBest(fInd)
+	}
+}
@@ -0,0 +1,34 @@
+package pso
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/support"
+)
+
+func TestBareBones(t *testing.T) {
+	maxGen, maxFES := 200, math.MaxInt64
+	size, dims := 20, 5
+	low, up := -5.12, 5.12
+	for _, exploit := range []bool{false, true} {
+		st, hof := newPSOStatistics(), support.NewDefaultHallOfFame(2, nil)
+		pop := newPSOPopulation(size, dims, low, up, 0.0, 0.0)
+		evol := NewBareBones(exploit, NewGlobalTopology(), maxGen, maxFES, st, hof, benchmarks.Sphere)
+		evol.SetBounds(bounds.NewBounds(low, up, bounds.Reflect, dims))
+		evol.Init(pop)
+		first := hof.Get(0).GetFitness().GetValues()[0]
+		evol.Run()
+		last := hof.Get(0).GetFitness().GetValues()[0]
+		if last > first {
+			t.Errorf("exploit=%v: the best fitness gets worse: %v -> %v", exploit, first, last)
+		}
+		if hof != evol.GetHallOfFame() {
+			t.Error("hof != evol.GetHallOfFame()")
+		}
+		t.Log("\n" + evol.GetLogbook().String())
+		t.Logf("exploit=%v: %v", exploit, hof.Get(0))
+	}
+}
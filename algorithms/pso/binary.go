@@ -0,0 +1,185 @@
+package pso
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/support"
+)
+
+// BinaryParticle is the individual of BinaryPSO, the speed of each bit decides the probability of the bit being true
+type BinaryParticle struct {
+	base.BoolIndividual
+
+	// speed of BinaryParticle
+	speed []float64
+	// pbest
+	pbest *BinaryParticle
+	// minimum of speed
+	smin float64
+	// maximum of speed
+	smax float64
+}
+
+// NewBinaryParticle returns a BinaryParticle
+func NewBinaryParticle(chromosome []bool, speed []float64, smin, smax float64, fitness *base.Fitness) *BinaryParticle {
+	return &BinaryParticle{
+		BoolIndividual: *base.NewBoolIndividual(chromosome, fitness),
+		speed:          speed,
+		smin:           smin,
+		smax:           smax,
+	}
+}
+
+// Clone returns an copy of BinaryParticle
+func (part *BinaryParticle) Clone() interface{} {
+	bInd := part.BoolIndividual.Clone().(*base.BoolIndividual)
+	cSpeed := make([]float64, len(part.speed))
+	copy(cSpeed, part.speed)
+	return &BinaryParticle{
+		BoolIndividual: *bInd,
+		speed:          cSpeed,
+		pbest:          part.pbest,
+		smin:           part.smin,
+		smax:           part.smax,
+	}
+}
+
+// IsEqual returns if the chromosome of other is equal to the particle's
+func (part *BinaryParticle) IsEqual(other base.Individual) bool {
+	if otherPart, ok := other.(*BinaryParticle); ok {
+		return part.BoolIndividual.IsEqual(&otherPart.BoolIndividual)
+	}
+	return part.BoolIndividual.IsEqual(other)
+}
+
+func (part *BinaryParticle) String() string {
+	fmtStr := "BinaryParticle{BoolIndividual:%v, speed:%v, smin:%v, smax: %v}"
+	return fmt.Sprintf(fmtStr, part.BoolIndividual.String(), part.speed, part.smin, part.smax)
+}
+
+// GetSpeed returns speed
+func (part *BinaryParticle) GetSpeed() []float64 {
+	return part.speed
+}
+
+// SetSpeed sets speed and check if is out of limits
+func (part *BinaryParticle) SetSpeed(speed []float64) {
+	if len(speed) != len(part.speed) {
+		panic(fmt.Sprintf("This speed's length is not equals to part.speed's: %v,%v", len(speed), len(part.speed)))
+	}
+	for i, v := range speed {
+		part.speed[i] = math.Max(part.smin, math.Min(part.smax, v))
+	}
+}
+
+// GetPBest returns pbest
+func (part *BinaryParticle) GetPBest() *BinaryParticle {
+	return part.pbest
+}
+
+// SetPBest sets the other as part's pbest
+func (part *BinaryParticle) SetPBest(other *BinaryParticle) {
+	part.pbest = other
+}
+
+// BinaryPSO is the binary particle swarm optimization [Kennedy1997].
+// The velocity is updated as the standard PSO with the bits regarded as 0 and 1,
+// then each bit is set to true with the probability sigmoid(v) = 1/(1+exp(-v)).
+// The speed limits of BinaryParticle keep the probabilities away from 0 and 1, the typical limits are [-4, 4] or [-6, 6].
+//
+// [Kennedy1997] Kennedy and Eberhart, "A discrete binary version of the particle swarm algorithm", 1997.
+type BinaryPSO struct {
+	swarm
+	velocity  VelocityUpdate
+	topology  Topology
+	evaluator benchmarks.BoolEvaluator
+}
+
+// NewBinaryPSO returns *BinaryPSO.
+// velocity is the VelocityUpdate, InertiaVelocity(2.0, 2.0, ConstantInertia(1.0)) gives the original binary PSO.
+// topology is the Topology defining the neighbourhood of each particle, such as NewGlobalTopology().
+// maxGen is maximum running generation.
+// maxFES is maximum function evalutions, maxFES < 0 means maxFES=INF.
+// stat is Statistics, optional.
+// hof is HallOfFame records the best individuals, optional.
+// evaluator is a BoolEvaluator.
+func NewBinaryPSO(velocity VelocityUpdate, topology Topology, maxGen, maxFES int, stat support.Statistics, hof support.HallOfFame, evaluator benchmarks.BoolEvaluator) *BinaryPSO {
+	return &BinaryPSO{
+		swarm:     swarm{maxGen: maxGen, maxFES: maxFES, stat: stat, hof: hof},
+		velocity:  velocity,
+		topology:  topology,
+		evaluator: evaluator,
+	}
+}
+
+// Init initializes the population and prepared for some data
+func (evol *BinaryPSO) Init(population base.Individuals) {
+	evol.reset(population)
+	if !evol.IsTerminated() {
+		for _, ind := range evol.population {
+			bInd := ind.(*BinaryParticle)
+			bInd.GetFitness().SetValues(evol.evaluator(&bInd.BoolIndividual))
+			bInd.SetPBest(bInd)
+		}
+		evol.currentFES += evol.size
+		evol.log()
+	}
+}
+
+// Evolve runs the evol a time/generation per call and return generation time
+func (evol *BinaryPSO) Evolve() interface{} {
+	evol.gen++
+	if !evol.IsTerminated() {
+		offsprings := make(base.Individuals, evol.size)
+		nbests := neighbourhoodBests(evol.population, evol.topology, binaryParticlePBest)
+		for i, ind := range evol.population {
+			cInd := ind.Clone().(*BinaryParticle)
+			speed := cInd.GetSpeed()
+			chrom := cInd.GetChromosome().([]bool)
+			evol.velocity(evol.gen, evol.maxGen, speed, bits(chrom), bits(cInd.GetPBest().GetChromosome().([]bool)), bits(nbests[i].GetChromosome().([]bool)))
+			cInd.SetSpeed(speed)
+			for j, v := range cInd.GetSpeed() {
+				chrom[j] = rand.Float64() < 1.0/(1.0+math.Exp(-v))
+			}
+			cInd.GetFitness().SetValues(evol.evaluator(&cInd.BoolIndividual))
+			if cInd.GetFitness().Greater(cInd.GetPBest().GetFitness()) {
+				cInd.SetPBest(cInd)
+			}
+			offsprings[i] = cInd
+		}
+		evol.population = offsprings
+		evol.currentFES += evol.size
+		// log
+		best := evol.hof.Get(0).GetFitness().Clone()
+		evol.log()
+		evol.topology.Update(evol.size, evol.hof.Get(0).GetFitness().Greater(best))
+	}
+	return evol.gen
+}
+
+// Run executes Evolve() until the terminal condition satisfied
+func (evol *BinaryPSO) Run() {
+	for !evol.IsTerminated() {
+		evol.Evolve()
+	}
+}
+
+// binaryParticlePBest returns the pbest of a BinaryParticle
+func binaryParticlePBest(ind base.Individual) base.Individual {
+	return ind.(*BinaryParticle).GetPBest()
+}
+
+// bits converts the bool slice to a slice of 0 and 1
+func bits(chrom []bool) []float64 {
+	values := make([]float64, len(chrom))
+	for i, c := range chrom {
+		if c {
+			values[i] = 1.0
+		}
+	}
+	return values
+}
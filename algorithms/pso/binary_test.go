@@ -0,0 +1,83 @@
+package pso
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/support"
+)
+
+func newBinaryPSOPopulation(size, dims int, vmax float64) base.Individuals {
+	pop := make(base.Individuals, size)
+	fit := base.NewFitness([]float64{1.0})
+	for i := range pop {
+		chrom, speed := make([]bool, dims), make([]float64, dims)
+		for j := range chrom {
+			chrom[j] = rand.Float64() < 0.5
+			speed[j] = vmax * (2.0*rand.Float64() - 1.0)
+		}
+		pop[i] = NewBinaryParticle(chrom, speed, -vmax, vmax, fit.Clone())
+	}
+	return pop
+}
+
+func TestBinaryPSO(t *testing.T) {
+	maxGen, maxFES := 200, math.MaxInt64
+	size, vmax := 30, 4.0
+	evaluators := map[string]struct {
+		dims      int
+		evaluator benchmarks.BoolEvaluator
+	}{
+		"ChuangF1": {41, benchmarks.ChuangF1},
+		"Trap": {8, func(ind *base.BoolIndividual) []float64 {
+			return benchmarks.Trap(ind)
+		}},
+		"RoyalRoad1": {32, func(ind *base.BoolIndividual) []float64 {
+			return benchmarks.RoyalRoad1(ind, 4)
+		}},
+	}
+	for name, e := range evaluators {
+		pop := newBinaryPSOPopulation(size, e.dims, vmax)
+		velocity := InertiaVelocity(2.0, 2.0, ConstantInertia(1.0))
+		evol := NewBinaryPSO(velocity, NewGlobalTopology(), maxGen, maxFES, newPSOStatistics(), support.NewDefaultHallOfFame(1, nil), e.evaluator)
+		evol.Init(pop)
+		evol.Run()
+		for _, ind := range evol.GetPopulation() {
+			part := ind.(*BinaryParticle)
+			for _, v := range part.GetSpeed() {
+				if v < -vmax || v > vmax {
+					t.Errorf("%s: speed is out of limits: %v", name, part)
+				}
+			}
+			if part.GetPBest().GetFitness().Less(part.GetFitness()) {
+				t.Errorf("%s: pbest is worse than the particle: %v", name, part)
+			}
+		}
+		t.Logf("%s: %v", name, evol.GetHallOfFame().Get(0))
+	}
+}
+
+func TestBinaryPSOMaximization(t *testing.T) {
+	maxGen, size, dims, vmax := 5, 30, 64, 4.0
+	// no block is complete in the initial swarm, so the best value of the maximization is 0
+	pop := newBinaryPSOPopulation(size, dims, vmax)
+	for _, ind := range pop {
+		ind.(*BinaryParticle).SetChromosome(make([]bool, dims))
+	}
+	evaluator := func(ind *base.BoolIndividual) []float64 {
+		return benchmarks.RoyalRoad1(ind, 8)
+	}
+	velocity := InertiaVelocity(2.0, 2.0, ConstantInertia(1.0))
+	evol := NewBinaryPSO(velocity, NewGlobalTopology(), maxGen, math.MaxInt64, nil, support.NewDefaultHallOfFame(1, nil), evaluator)
+	evol.Init(pop)
+	if evol.IsTerminated() {
+		t.Fatalf("the maximization shouldn't stop after Init with the best value %v", evol.GetHallOfFame().Get(0).GetFitness())
+	}
+	evol.Run()
+	if evol.gen != maxGen {
+		t.Errorf("the maximization should run %d generations: %d", maxGen, evol.gen)
+	}
+}
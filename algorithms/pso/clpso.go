@@ -0,0 +1,179 @@
+package pso
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/support"
+)
+
+// CLPSO is the comprehensive learning particle swarm optimizer [Liang2006].
+// Each dimension of a particle learns from the pbest of an exemplar particle, the exemplar of each dimension is the particle itself or the winner of a tournament between two other particles,
+// which is chosen with the learning probability Pc_i of the particle.
+// The exemplars of a particle are reassigned when its pbest has not been improved for m (refreshing gap) generations.
+//
+// v = w*v + c*r*(pbest_{f_i(d)} - x)
+//
+// If bounds are set, the particles out of the bounds are not evaluated and their fitness is invalidated, so that their pbests are only updated in the bounds.
+// The statistics and the HallOfFame are computed from the pbests of the particles, which are always evaluated.
+//
+// [Liang2006] Liang, Qin, Suganthan and Baskar, "Comprehensive learning particle swarm optimizer for global optimization of multimodal functions", 2006.
+type CLPSO struct {
+	swarm
+	c         float64
+	w         InertiaWeight
+	m         int
+	pc        []float64
+	exemplars [][]int
+	flags     []int
+	evaluator benchmarks.Float64Evaluator
+	bounds    *bounds.Bounds
+}
+
+// NewCLPSO returns *CLPSO.
+// c is the acceleration coefficient, the typical value is 1.49445.
+// w is the InertiaWeight, the typical value is LinearDecreasingInertia(0.9, 0.4).
+// m is the refreshing gap, the typical value is 7.
+// maxGen is maximum running generation.
+// maxFES is maximum function evalutions, maxFES < 0 means maxFES=INF.
+// stat is Statistics, optional.
+// hof is HallOfFame records the best individuals, optional.
+// evaluator is a Float64Evaluator.
+func NewCLPSO(c float64, w InertiaWeight, m int, maxGen, maxFES int, stat support.Statistics, hof support.HallOfFame, evaluator benchmarks.Float64Evaluator) *CLPSO {
+	return &CLPSO{
+		swarm:     swarm{maxGen: maxGen, maxFES: maxFES, stat: stat, hof: hof},
+		c:         c,
+		w:         w,
+		m:         m,
+		evaluator: evaluator,
+	}
+}
+
+// SetBounds sets the bounds of the search space, the particles out of the bounds are not evaluated
+func (evol *CLPSO) SetBounds(b *bounds.Bounds) {
+	evol.bounds = b
+}
+
+// Init initializes the population and prepared for some data
+func (evol *CLPSO) Init(population base.Individuals) {
+	evol.reset(population)
+	evol.pc = make([]float64, evol.size)
+	evol.exemplars = make([][]int, evol.size)
+	evol.flags = make([]int, evol.size)
+	for i := range evol.pc {
+		evol.pc[i] = 0.05
+		if evol.size > 1 {
+			evol.pc[i] += 0.45 * (math.Exp(10.0*float64(i)/float64(evol.size-1)) - 1.0) / (math.Exp(10.0) - 1.0)
+		}
+	}
+	if !evol.IsTerminated() {
+		evaluateParticles(evol.population, evol.evaluator)
+		evol.currentFES += evol.size
+		for i := range evol.population {
+			evol.assignExemplars(i)
+		}
+		evol.logIndividuals(evol.pbests())
+	}
+}
+
+// Evolve runs the evol a time/generation per call and return generation time
+func (evol *CLPSO) Evolve() interface{} {
+	evol.gen++
+	if !evol.IsTerminated() {
+		offsprings := make(base.Individuals, evol.size)
+		w := evol.w(evol.gen, evol.maxGen)
+		for i, ind := range evol.population {
+			if evol.flags[i] >= evol.m {
+				evol.assignExemplars(i)
+				evol.flags[i] = 0
+			}
+			cInd := ind.Clone().(*Particle)
+			speed := cInd.GetSpeed()
+			chrom := cInd.GetChromosome().([]float64)
+			for j, e := range evol.exemplars[i] {
+				eChrom := evol.population[e].(*Particle).GetPBest().GetChromosome().([]float64)
+				speed[j] = w*speed[j] + evol.c*rand.Float64()*(eChrom[j]-chrom[j])
+			}
+			cInd.SetSpeed(speed)
+			for j, v := range cInd.GetSpeed() {
+				chrom[j] += v
+			}
+			improved := false
+			if evol.bounds == nil || evol.bounds.Contains(chrom) {
+				cInd.GetFitness().SetValues(evol.evaluator(&cInd.Float64Individual))
+				evol.currentFES++
+				if cInd.GetFitness().Greater(cInd.GetPBest().GetFitness()) {
+					cInd.SetPBest(cInd)
+					improved = true
+				}
+			} else {
+				cInd.GetFitness().Invalidate()
+			}
+			if improved {
+				evol.flags[i] = 0
+			} else {
+				evol.flags[i]++
+			}
+			offsprings[i] = cInd
+		}
+		evol.population = offsprings
+		// log
+		evol.logIndividuals(evol.pbests())
+	}
+	return evol.gen
+}
+
+// Run executes Evolve() until the terminal condition satisfied
+func (evol *CLPSO) Run() {
+	for !evol.IsTerminated() {
+		evol.Evolve()
+	}
+}
+
+// pbests returns the pbests of the particles
+func (evol *CLPSO) pbests() base.Individuals {
+	pbests := make(base.Individuals, evol.size)
+	for i, ind := range evol.population {
+		pbests[i] = ind.(*Particle).GetPBest()
+	}
+	return pbests
+}
+
+// assignExemplars assigns the exemplar of each dimension of particle i
+func (evol *CLPSO) assignExemplars(i int) {
+	dims := evol.population[i].Len()
+	exemplars := make([]int, dims)
+	learnFromOthers := false
+	for d := range exemplars {
+		exemplars[d] = i
+		if evol.size > 1 && rand.Float64() < evol.pc[i] {
+			exemplars[d] = evol.tournament(i)
+			learnFromOthers = true
+		}
+	}
+	if !learnFromOthers && evol.size > 1 {
+		exemplars[rand.Intn(dims)] = evol.tournament(i)
+	}
+	evol.exemplars[i] = exemplars
+}
+
+// tournament returns the particle whose pbest is better in two particles randomly chosen (different from particle i)
+func (evol *CLPSO) tournament(i int) int {
+	pick := func() int {
+		j := rand.Intn(evol.size - 1)
+		if j >= i {
+			j++
+		}
+		return j
+	}
+	f1, f2 := pick(), pick()
+	p1 := evol.population[f1].(*Particle).GetPBest()
+	p2 := evol.population[f2].(*Particle).GetPBest()
+	if p2.GetFitness().Greater(p1.GetFitness()) {
+		return f2
+	}
+	return f1
+}
@@ -0,0 +1,67 @@
+package pso
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/support"
+)
+
+func TestCLPSO(t *testing.T) {
+	maxGen, maxFES := 200, math.MaxInt64
+	size, dims := 20, 10
+	low, up := -5.12, 5.12
+	smin, smax := -1.024, 1.024
+	st, hof := newPSOStatistics(), support.NewDefaultHallOfFame(2, nil)
+	pop := newPSOPopulation(size, dims, low, up, smin, smax)
+	evol := NewCLPSO(1.49445, LinearDecreasingInertia(0.9, 0.4), 7, maxGen, maxFES, st, hof, benchmarks.Rastrigin)
+	evol.SetBounds(bounds.NewBounds(low, up, bounds.Clip, dims))
+	evol.Init(pop)
+	first := hof.Get(0).GetFitness().GetValues()[0]
+	evol.Run()
+	last := hof.Get(0).GetFitness().GetValues()[0]
+	if last > first {
+		t.Errorf("the best fitness gets worse: %v -> %v", first, last)
+	}
+	for i, exemplars := range evol.exemplars {
+		if len(exemplars) != dims {
+			t.Errorf("particle %d should have %d exemplars: %v", i, dims, exemplars)
+		}
+	}
+	if !bounds.NewBounds(low, up, bounds.Clip, dims).Contains(hof.Get(0).GetChromosome().([]float64)) {
+		t.Errorf("the best particle is out of bounds: %v", hof.Get(0))
+	}
+	t.Log("\n" + evol.GetLogbook().String())
+	t.Log(hof.Get(0))
+}
+
+func TestCLPSOOutOfBounds(t *testing.T) {
+	size, dims := 10, 5
+	low, up := -5.12, 5.12
+	b := bounds.NewBounds(low, up, bounds.Clip, dims)
+	hof := support.NewDefaultHallOfFame(5, nil)
+	pop := newPSOPopulation(size, dims, low, up, -3.0, 3.0)
+	evol := NewCLPSO(1.49445, LinearDecreasingInertia(0.9, 0.4), 7, 50, math.MaxInt64, nil, hof, benchmarks.Rastrigin)
+	evol.SetBounds(b)
+	evol.Init(pop)
+	for !evol.IsTerminated() {
+		evol.Evolve()
+		for _, ind := range evol.GetPopulation() {
+			if !b.Contains(ind.GetChromosome().([]float64)) && ind.GetFitness().Valid() {
+				t.Fatalf("the particle out of bounds shouldn't have a valid fitness: %v", ind)
+			}
+		}
+		// the HallOfFame is updated by the evaluated pbests only
+		for i := 0; i < hof.Len(); i++ {
+			best := hof.Get(i)
+			if !b.Contains(best.GetChromosome().([]float64)) {
+				t.Fatalf("the best particle is out of bounds: %v", best)
+			}
+			if v := benchmarks.Rastrigin(&best.(*Particle).Float64Individual)[0]; v != best.GetFitness().GetValues()[0] {
+				t.Fatalf("the best particle has a stale fitness %v: %v", best.GetFitness().GetValues()[0], v)
+			}
+		}
+	}
+}
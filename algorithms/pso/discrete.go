@@ -0,0 +1,201 @@
+package pso
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/support"
+	"github.com/sineatos/deag/utility"
+)
+
+// IntParticle is the individual of IntPSO
+type IntParticle struct {
+	base.IntIndividual
+
+	// speed of IntParticle
+	speed []float64
+	// pbest
+	pbest *IntParticle
+	// minimum of speed
+	smin float64
+	// maximum of speed
+	smax float64
+}
+
+// NewIntParticle returns a IntParticle
+func NewIntParticle(chromosome []int, speed []float64, smin, smax float64, fitness *base.Fitness) *IntParticle {
+	return &IntParticle{
+		IntIndividual: *base.NewIntIndividual(chromosome, fitness),
+		speed:         speed,
+		smin:          smin,
+		smax:          smax,
+	}
+}
+
+// Clone returns an copy of IntParticle
+func (part *IntParticle) Clone() interface{} {
+	iInd := part.IntIndividual.Clone().(*base.IntIndividual)
+	cSpeed := make([]float64, len(part.speed))
+	copy(cSpeed, part.speed)
+	return &IntParticle{
+		IntIndividual: *iInd,
+		speed:         cSpeed,
+		pbest:         part.pbest,
+		smin:          part.smin,
+		smax:          part.smax,
+	}
+}
+
+// IsEqual returns if the chromosome of other is equal to the particle's
+func (part *IntParticle) IsEqual(other base.Individual) bool {
+	if otherPart, ok := other.(*IntParticle); ok {
+		return part.IntIndividual.IsEqual(&otherPart.IntIndividual)
+	}
+	return part.IntIndividual.IsEqual(other)
+}
+
+func (part *IntParticle) String() string {
+	fmtStr := "IntParticle{IntIndividual:%v, speed:%v, smin:%v, smax: %v}"
+	return fmt.Sprintf(fmtStr, part.IntIndividual.String(), part.speed, part.smin, part.smax)
+}
+
+// GetSpeed returns speed
+func (part *IntParticle) GetSpeed() []float64 {
+	return part.speed
+}
+
+// SetSpeed sets speed and check if is out of limits
+func (part *IntParticle) SetSpeed(speed []float64) {
+	if len(speed) != len(part.speed) {
+		panic(fmt.Sprintf("This speed's length is not equals to part.speed's: %v,%v", len(speed), len(part.speed)))
+	}
+	for i, v := range speed {
+		part.speed[i] = math.Max(part.smin, math.Min(part.smax, v))
+	}
+}
+
+// GetPBest returns pbest
+func (part *IntParticle) GetPBest() *IntParticle {
+	return part.pbest
+}
+
+// SetPBest sets the other as part's pbest
+func (part *IntParticle) SetPBest(other *IntParticle) {
+	part.pbest = other
+}
+
+// IntPSO is the discrete particle swarm optimization for integer variables.
+// The velocity is updated as the standard PSO, then the new position is rounded to the nearest integers:
+//
+// x = round(x + v)
+//
+// If bounds are set, the components of the position out of the bounds are clipped to the bounds.
+type IntPSO struct {
+	swarm
+	velocity  VelocityUpdate
+	topology  Topology
+	evaluator benchmarks.IntEvaluator
+	low, up   interface{}
+}
+
+// NewIntPSO returns *IntPSO.
+// velocity is the VelocityUpdate, such as ConstrictionVelocity(2.05, 2.05).
+// topology is the Topology defining the neighbourhood of each particle, such as NewGlobalTopology().
+// maxGen is maximum running generation.
+// maxFES is maximum function evalutions, maxFES < 0 means maxFES=INF.
+// stat is Statistics, optional.
+// hof is HallOfFame records the best individuals, optional.
+// evaluator is an IntEvaluator.
+func NewIntPSO(velocity VelocityUpdate, topology Topology, maxGen, maxFES int, stat support.Statistics, hof support.HallOfFame, evaluator benchmarks.IntEvaluator) *IntPSO {
+	return &IntPSO{
+		swarm:     swarm{maxGen: maxGen, maxFES: maxFES, stat: stat, hof: hof},
+		velocity:  velocity,
+		topology:  topology,
+		evaluator: evaluator,
+	}
+}
+
+// SetBounds sets the bounds of the search space, low and up are int or []int
+func (evol *IntPSO) SetBounds(low, up interface{}) {
+	evol.low, evol.up = low, up
+}
+
+// Init initializes the population and prepared for some data
+func (evol *IntPSO) Init(population base.Individuals) {
+	evol.reset(population)
+	if !evol.IsTerminated() {
+		for _, ind := range evol.population {
+			iInd := ind.(*IntParticle)
+			iInd.GetFitness().SetValues(evol.evaluator(&iInd.IntIndividual))
+			iInd.SetPBest(iInd)
+		}
+		evol.currentFES += evol.size
+		evol.log()
+	}
+}
+
+// Evolve runs the evol a time/generation per call and return generation time
+func (evol *IntPSO) Evolve() interface{} {
+	evol.gen++
+	if !evol.IsTerminated() {
+		offsprings := make(base.Individuals, evol.size)
+		nbests := neighbourhoodBests(evol.population, evol.topology, intParticlePBest)
+		for i, ind := range evol.population {
+			cInd := ind.Clone().(*IntParticle)
+			speed := cInd.GetSpeed()
+			chrom := cInd.GetChromosome().([]int)
+			pos := ints2Float64s(chrom)
+			evol.velocity(evol.gen, evol.maxGen, speed, pos, ints2Float64s(cInd.GetPBest().GetChromosome().([]int)), ints2Float64s(nbests[i].GetChromosome().([]int)))
+			cInd.SetSpeed(speed)
+			for j, v := range cInd.GetSpeed() {
+				chrom[j] = int(math.Floor(pos[j] + v + 0.5))
+			}
+			if evol.low != nil && evol.up != nil {
+				low := utility.Interface2IntSlice("low", evol.low, len(chrom))
+				up := utility.Interface2IntSlice("up", evol.up, len(chrom))
+				for j, c := range chrom {
+					if c < low[j] {
+						chrom[j] = low[j]
+					} else if c > up[j] {
+						chrom[j] = up[j]
+					}
+				}
+			}
+			cInd.GetFitness().SetValues(evol.evaluator(&cInd.IntIndividual))
+			if cInd.GetFitness().Greater(cInd.GetPBest().GetFitness()) {
+				cInd.SetPBest(cInd)
+			}
+			offsprings[i] = cInd
+		}
+		evol.population = offsprings
+		evol.currentFES += evol.size
+		// log
+		best := evol.hof.Get(0).GetFitness().Clone()
+		evol.log()
+		evol.topology.Update(evol.size, evol.hof.Get(0).GetFitness().Greater(best))
+	}
+	return evol.gen
+}
+
+// Run executes Evolve() until the terminal condition satisfied
+func (evol *IntPSO) Run() {
+	for !evol.IsTerminated() {
+		evol.Evolve()
+	}
+}
+
+// intParticlePBest returns the pbest of an IntParticle
+func intParticlePBest(ind base.Individual) base.Individual {
+	return ind.(*IntParticle).GetPBest()
+}
+
+// ints2Float64s converts the int slice to a float64 slice
+func ints2Float64s(chrom []int) []float64 {
+	values := make([]float64, len(chrom))
+	for i, c := range chrom {
+		values[i] = float64(c)
+	}
+	return values
+}
@@ -0,0 +1,55 @@
+package pso
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/support"
+)
+
+func newIntPSOPopulation(size, dims, low, up int, smin, smax float64) base.Individuals {
+	pop := make(base.Individuals, size)
+	fit := base.NewFitness([]float64{-1.0})
+	for i := range pop {
+		chrom, speed := make([]int, dims), make([]float64, dims)
+		for j := range chrom {
+			chrom[j] = low + rand.Intn(up-low+1)
+			speed[j] = smin + rand.Float64()*(smax-smin)
+		}
+		pop[i] = NewIntParticle(chrom, speed, smin, smax, fit.Clone())
+	}
+	return pop
+}
+
+func TestIntPSO(t *testing.T) {
+	maxGen, maxFES := 200, math.MaxInt64
+	size, dims := 20, 5
+	low, up := -10, 10
+	// the optimum is [3, 3, ..., 3]
+	evaluator := func(ind *base.IntIndividual) []float64 {
+		total := 0.0
+		for _, c := range ind.GetChromosome().([]int) {
+			total += float64((c - 3) * (c - 3))
+		}
+		return []float64{total}
+	}
+	pop := newIntPSOPopulation(size, dims, low, up, -4.0, 4.0)
+	evol := NewIntPSO(ConstrictionVelocity(2.05, 2.05), NewRingTopology(1), maxGen, maxFES, newPSOStatistics(), support.NewDefaultHallOfFame(1, nil), evaluator)
+	evol.SetBounds(low, up)
+	evol.Init(pop)
+	evol.Run()
+	for _, ind := range evol.GetPopulation() {
+		for _, c := range ind.GetChromosome().([]int) {
+			if c < low || c > up {
+				t.Errorf("particle is out of bounds: %v", ind)
+			}
+		}
+	}
+	best := evol.GetHallOfFame().Get(0)
+	if best.GetFitness().GetValues()[0] > 4.0 {
+		t.Errorf("IntPSO doesn't approach the optimum: %v", best)
+	}
+	t.Log(best)
+}
@@ -0,0 +1,50 @@
+package pso
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/dynamic"
+	"github.com/sineatos/deag/tools/inits"
+)
+
+func TestPSODynamic(t *testing.T) {
+	size, dims, period := 20, 5, 500
+	sc := benchmarks.MovingPeaksScenario2()
+	sc.Period = period
+	mp := benchmarks.NewMovingPeaks(dims, sc)
+	// the evolution stops when the best value is less than 1E-14, so the values are shifted to be positive
+	evaluator := func(ind *base.Float64Individual) []float64 { return []float64{mp.Evaluate(ind)[0] + 1E4} }
+	b := bounds.NewBounds(sc.MinCoord, sc.MaxCoord, bounds.Clip, dims)
+	h := dynamic.NewHandler(1, dynamic.Hypermutation(0.05, 0.5, b))
+	h.SetErrors(mp.OfflineError, mp.CurrentError)
+	pop := make(base.Individuals, size)
+	limit := func() float64 { return sc.MinCoord + rand.Float64()*(sc.MaxCoord-sc.MinCoord) }
+	sLimit := func() float64 { return -5.0 + rand.Float64()*10.0 }
+	for i := range pop {
+		x, v := inits.GenerateFloat64SliceRepeat(limit, dims), inits.GenerateFloat64SliceRepeat(sLimit, dims)
+		pop[i] = NewParticle(x, v, -5.0, 5.0, base.NewFitness([]float64{1.0}))
+	}
+	evol := NewPSOWithTopology(ConstrictionVelocity(2.05, 2.05), NewGlobalTopology(), 200, -1, nil, nil, evaluator)
+	evol.SetBounds(b)
+	evol.SetDynamic(h)
+	evol.Init(pop)
+	evol.Run()
+	if changes := mp.GetNEvals() / period; h.GetChanges() != changes && h.GetChanges() != changes-1 {
+		t.Errorf("%v changes should be detected: %v", changes, h.GetChanges())
+	}
+	// the pbests are never better than the particles after they are re-evaluated in the new environment
+	for _, ind := range evol.GetPopulation() {
+		if pbest := ind.(*Particle).GetPBest(); pbest.GetFitness().Less(ind.GetFitness()) {
+			t.Errorf("the pbest is worse than the particle: %v, %v", pbest, ind)
+		}
+	}
+	records := evol.GetLogbook().Select([]string{dynamic.CURRENTERROR})
+	if e, ok := records[len(records)-1][0].(float64); !ok || e < 0.0 {
+		t.Errorf("the current error should be logged: %v", records[len(records)-1])
+	}
+	t.Logf("%v changes, offline error %v", h.GetChanges(), mp.OfflineError())
+}
@@ -0,0 +1,174 @@
+package pso
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/support"
+)
+
+// MOPSO is the multi-objective particle swarm optimization [Coello2004].
+// The leader of each particle is selected from the external Archive, the pbest is replaced when the new position dominates it,
+// and is replaced with the probability 0.5 when they are mutually non-dominated.
+//
+// The turbulence mutates a random dimension of a particle with the probability (1-gen/maxGen)^(1/mutation),
+// the new value is sampled from the range around the position whose width decreases with the same factor.
+// If the position is out of bounds, it is repaired and the speeds of the repaired dimensions are reversed.
+//
+// [Coello2004] Coello, Pulido and Lechuga, "Handling multiple objectives with particle swarm optimization", 2004.
+type MOPSO struct {
+	population base.Individuals
+	stat       support.Statistics
+	archive    Archive
+	logbook    support.Logbook
+	size       int
+	maxFES     int
+	currentFES int
+	gen        int
+	maxGen     int
+	velocity   VelocityUpdate
+	mutation   float64
+	bounds     *bounds.Bounds
+	evaluator  benchmarks.Float64Evaluator
+}
+
+// NewMOPSO returns *MOPSO.
+// velocity is the VelocityUpdate, [Coello2004] uses InertiaVelocity(1.0, 1.0, ConstantInertia(0.4)).
+// archive is the Archive, such as NewGridArchive(100, 30) or NewCrowdingArchive(100).
+// mutation is the mutation rate of the turbulence, the typical value is 0.5, mutation <= 0 disables the turbulence.
+// b is the bounds of the search space, the turbulence is disabled if b is nil.
+// maxGen is maximum running generation.
+// maxFES is maximum function evalutions, maxFES < 0 means maxFES=INF.
+// stat is Statistics, optional.
+// evaluator is a multi-objective Float64Evaluator.
+func NewMOPSO(velocity VelocityUpdate, archive Archive, mutation float64, b *bounds.Bounds, maxGen, maxFES int, stat support.Statistics, evaluator benchmarks.Float64Evaluator) *MOPSO {
+	return &MOPSO{
+		stat:      stat,
+		archive:   archive,
+		maxFES:    maxFES,
+		maxGen:    maxGen,
+		velocity:  velocity,
+		mutation:  mutation,
+		bounds:    b,
+		evaluator: evaluator,
+	}
+}
+
+// Init initializes the population and prepared for some data
+func (evol *MOPSO) Init(population base.Individuals) {
+	evol.currentFES = 0
+	evol.gen = 0
+	evol.logbook = support.NewDefaultLogbook(evol.maxGen, 0)
+	evol.population = population
+	evol.size = population.Len()
+	if !evol.IsTerminated() {
+		evaluateParticles(evol.population, evol.evaluator)
+		evol.currentFES += evol.size
+		evol.archive.Update(evol.population)
+		evol.log()
+	}
+}
+
+// IsTerminated returns if the evolution is terminated
+func (evol *MOPSO) IsTerminated() (flag bool) {
+	flag = evol.gen >= evol.maxGen
+	flag = flag || (evol.maxFES > 0 && evol.currentFES+evol.size > evol.maxFES)
+	return
+}
+
+// Evolve runs the evol a time/generation per call and return generation time
+func (evol *MOPSO) Evolve() interface{} {
+	evol.gen++
+	if !evol.IsTerminated() {
+		offsprings := make(base.Individuals, evol.size)
+		for i, ind := range evol.population {
+			cInd := ind.Clone().(*Particle)
+			speed := cInd.GetSpeed()
+			chrom := cInd.GetChromosome().([]float64)
+			pChrom := cInd.GetPBest().GetChromosome().([]float64)
+			lChrom := evol.archive.SelectLeader().GetChromosome().([]float64)
+			evol.velocity(evol.gen, evol.maxGen, speed, chrom, pChrom, lChrom)
+			cInd.SetSpeed(speed)
+			for j, v := range cInd.GetSpeed() {
+				chrom[j] += v
+			}
+			if evol.bounds != nil {
+				evol.turbulence(chrom)
+				moved := make([]float64, len(chrom))
+				copy(moved, chrom)
+				evol.bounds.Repair(chrom, ind.GetChromosome().([]float64))
+				for j := range speed {
+					if chrom[j] != moved[j] {
+						speed[j] = -speed[j]
+					}
+				}
+			}
+			cInd.GetFitness().SetValues(evol.evaluator(&cInd.Float64Individual))
+			pFit := cInd.GetPBest().GetFitness()
+			if cInd.GetFitness().Dominates(pFit, nil) || (!pFit.Dominates(cInd.GetFitness(), nil) && rand.Float64() < 0.5) {
+				cInd.SetPBest(cInd)
+			}
+			offsprings[i] = cInd
+		}
+		evol.population = offsprings
+		evol.currentFES += evol.size
+		evol.archive.Update(evol.population)
+		// log
+		evol.log()
+	}
+	return evol.gen
+}
+
+// Run executes Evolve() until the terminal condition satisfied
+func (evol *MOPSO) Run() {
+	for !evol.IsTerminated() {
+		evol.Evolve()
+	}
+}
+
+// GetLogbook returns the logbook saving data
+func (evol *MOPSO) GetLogbook() support.Logbook {
+	return evol.logbook
+}
+
+// GetArchive returns the archive saving the non-dominated solutions
+func (evol *MOPSO) GetArchive() Archive {
+	return evol.archive
+}
+
+// GetPopulation returns the current swarm
+func (evol *MOPSO) GetPopulation() base.Individuals {
+	return evol.population
+}
+
+// turbulence mutates a random dimension of chrom
+func (evol *MOPSO) turbulence(chrom []float64) {
+	if evol.mutation <= 0.0 || evol.maxGen <= 0 {
+		return
+	}
+	rate := math.Pow(1.0-float64(evol.gen)/float64(evol.maxGen), 1.0/evol.mutation)
+	if rand.Float64() < rate {
+		j := rand.Intn(len(chrom))
+		width := (evol.bounds.Up(j) - evol.bounds.Low(j)) * rate
+		low := math.Max(chrom[j]-width, evol.bounds.Low(j))
+		up := math.Min(chrom[j]+width, evol.bounds.Up(j))
+		if low < up {
+			chrom[j] = low + rand.Float64()*(up-low)
+		}
+	}
+}
+
+func (evol *MOPSO) log() {
+	var datas support.Dict
+	if evol.stat != nil {
+		datas = evol.stat.Compile(evol.population)
+	} else {
+		datas = make(support.Dict, 3)
+	}
+	datas[support.GEN] = evol.gen
+	datas[support.FES] = evol.size
+	evol.logbook.Record(datas)
+}
@@ -0,0 +1,71 @@
+package pso
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/support"
+)
+
+func newMOPSOStatistics() support.Statistics {
+	mStat := support.NewDefaultMultiStatistics("MultiStatistics")
+	indStat := support.NewStatisticsBasedOnMOFitness("")
+	indStat.Register("min", support.StatMOFitnessMin)
+	indStat.Register("max", support.StatMOFitnessMax)
+	mStat.AddStats(indStat)
+	return mStat
+}
+
+func newMOPSOPopulation(size, dims int, low, up, smin, smax float64) base.Individuals {
+	pop := newPSOPopulation(size, dims, low, up, smin, smax)
+	fit := base.NewFitness([]float64{-1.0, -1.0})
+	for i, ind := range pop {
+		part := ind.(*Particle)
+		pop[i] = NewParticle(part.GetChromosome().([]float64), part.GetSpeed(), smin, smax, fit.Clone())
+	}
+	return pop
+}
+
+func TestMOPSO(t *testing.T) {
+	maxGen, maxFES := 100, math.MaxInt64
+	size, dims := 50, 10
+	low, up := 0.0, 1.0
+	archives := map[string]Archive{
+		"crowding": NewCrowdingArchive(50),
+		"grid":     NewGridArchive(50, 30),
+	}
+	for name, archive := range archives {
+		b := bounds.NewBounds(low, up, bounds.Clip, dims)
+		pop := newMOPSOPopulation(size, dims, low, up, -0.5, 0.5)
+		velocity := InertiaVelocity(1.0, 1.0, ConstantInertia(0.4))
+		evol := NewMOPSO(velocity, archive, 0.5, b, maxGen, maxFES, newMOPSOStatistics(), benchmarks.ZDT1)
+		evol.Init(pop)
+		evol.Run()
+		if evol.GetArchive().Len() > 50 {
+			t.Errorf("%s: the size of archive is greater than 50: %v", name, evol.GetArchive().Len())
+		}
+		for _, ind := range evol.GetPopulation() {
+			part := ind.(*Particle)
+			if !b.Contains(part.GetChromosome().([]float64)) {
+				t.Errorf("%s: particle is out of bounds: %v", name, part)
+			}
+			if part.GetFitness().Dominates(part.GetPBest().GetFitness(), nil) {
+				t.Errorf("%s: particle dominates its pbest: %v", name, part)
+			}
+		}
+		// the archive should approach the Pareto front f2 = 1 - sqrt(f1)
+		gd := 0.0
+		for _, m := range evol.GetArchive().GetMembers() {
+			values := m.GetFitness().GetValues()
+			gd += values[1] - (1.0 - math.Sqrt(values[0]))
+		}
+		gd /= float64(evol.GetArchive().Len())
+		if gd > 0.5 {
+			t.Errorf("%s: the archive is far from the Pareto front: %v", name, gd)
+		}
+		t.Logf("%s: archive size %v, distance to the Pareto front %v", name, evol.GetArchive().Len(), gd)
+	}
+}
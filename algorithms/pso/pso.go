@@ -1,31 +1,28 @@
 package pso
 
 import (
-	"math/rand"
+	"fmt"
 
 	"github.com/sineatos/deag/base"
 	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/dynamic"
 	"github.com/sineatos/deag/tools/support"
 )
 
 // PSO is the standard particle swarm optimaization
 type PSO struct {
-	population base.Individuals
-	c1         float64
-	c2         float64
-	gbest      *Particle
-	stat       support.Statistics
-	hof        support.HallOfFame
-	logbook    support.Logbook
-	size       int
-	maxFES     int
-	currentFES int
-	gen        int
-	maxGen     int
-	evaluator  benchmarks.Float64Evaluator
+	swarm
+	velocity  VelocityUpdate
+	topology  Topology
+	evaluator benchmarks.Float64Evaluator
+	bounds    *bounds.Bounds
+	greedy    bool
 }
 
-// NewPSO returns *PSO.
+// NewPSO returns *PSO using the velocity update without inertia (the original PSO).
+// A particle only moves to the new position if it is better, otherwise it keeps its previous position and speed, see SetGreedy.
+// The velocity update can be changed by SetVelocity.
 // c1 is coefficient of local information.
 // c2 is coefficient of global information
 // maxGen is maximum running generation.
@@ -33,77 +30,120 @@ type PSO struct {
 // stat is Statistics, optional.
 // hof is HallOfFame records the best individuals, optional.
 // evaluator is a Float64Evaluator.
-func NewPSO(c1, c2 float64, maxGen, maxFES int, stat support.Statistics, hof support.HallOfFame, evaluator benchmarks.Float64Evaluator) *PSO {
+// topology is the Topology, such as NewStarTopology(), NewRingTopology(1), NewVonNeumannTopology() or NewRandomTopology(3), optional,
+// the global (star) topology is used if it is omitted.
+func NewPSO(c1, c2 float64, maxGen, maxFES int, stat support.Statistics, hof support.HallOfFame, evaluator benchmarks.Float64Evaluator, topology ...Topology) *PSO {
+	if len(topology) > 1 {
+		panic(fmt.Sprintf("NewPSO accepts at most one topology: %v", topology))
+	}
+	var topo Topology = NewGlobalTopology()
+	if len(topology) == 1 {
+		topo = topology[0]
+	}
+	evol := NewPSOWithTopology(InertiaVelocity(c1, c2, ConstantInertia(1.0)), topo, maxGen, maxFES, stat, hof, evaluator)
+	evol.greedy = true
+	return evol
+}
+
+// NewPSOWithTopology returns *PSO, the particles always move to their new positions.
+// velocity is the VelocityUpdate, such as InertiaVelocity(c1, c2, LinearDecreasingInertia(0.9, 0.4)) or ConstrictionVelocity(2.05, 2.05).
+// topology is the Topology defining the neighbourhood of each particle, such as NewRingTopology(1).
+// maxGen is maximum running generation.
+// maxFES is maximum function evalutions, maxFES < 0 means maxFES=INF.
+// stat is Statistics, optional.
+// hof is HallOfFame records the best individuals, optional.
+// evaluator is a Float64Evaluator.
+func NewPSOWithTopology(velocity VelocityUpdate, topology Topology, maxGen, maxFES int, stat support.Statistics, hof support.HallOfFame, evaluator benchmarks.Float64Evaluator) *PSO {
 	return &PSO{
-		c1:        c1,
-		c2:        c2,
-		maxGen:    maxGen,
-		maxFES:    maxFES,
-		stat:      stat,
-		hof:       hof,
+		swarm:     swarm{maxGen: maxGen, maxFES: maxFES, stat: stat, hof: hof},
+		velocity:  velocity,
+		topology:  topology,
 		evaluator: evaluator,
 	}
 }
 
+// SetTopology sets the Topology defining the neighbourhood of each particle
+func (evol *PSO) SetTopology(topology Topology) {
+	evol.topology = topology
+}
+
+// SetVelocity sets the VelocityUpdate of the particles
+func (evol *PSO) SetVelocity(velocity VelocityUpdate) {
+	evol.velocity = velocity
+}
+
+// SetGreedy sets if a particle only moves to its new position when the new position is better,
+// otherwise the particle keeps its previous position and speed
+func (evol *PSO) SetGreedy(greedy bool) {
+	evol.greedy = greedy
+}
+
+// SetBounds sets the bounds of the search space, the positions out of the bounds are repaired with the previous positions as parents
+func (evol *PSO) SetBounds(b *bounds.Bounds) {
+	evol.bounds = b
+}
+
+// SetDynamic sets the Handler of the dynamic optimization, the changes are detected at the beginning of each generation.
+// When a change is detected, the responses of h are applied, then the particles, their pbests and the HallOfFame are re-evaluated,
+// and a pbest is replaced by the current position if the position is better in the new environment.
+func (evol *PSO) SetDynamic(h *dynamic.Handler) {
+	evol.dynamic = h
+}
+
 // Init initializes the population and prepared for some data
 func (evol *PSO) Init(population base.Individuals) {
-	evol.currentFES = 0
-	evol.gen = 0
-	evol.logbook = support.NewDefaultLogbook(evol.maxGen, 0)
-	evol.population = population
-	evol.size = population.Len()
-
+	evol.reset(population)
 	if !evol.IsTerminated() {
-		evol.evaluate(evol.population)
+		evaluateParticles(evol.population, evol.evaluator)
+		evol.currentFES += evol.size
+		if evol.dynamic != nil {
+			evol.dynamic.Reset()
+			evol.dynamic.Watch(evol.population)
+		}
 		evol.log()
 	}
 }
 
-// IsTerminated returns if the evolution is terminated
-func (evol *PSO) IsTerminated() (flag bool) {
-	flag = evol.gen >= evol.maxGen
-	flag = flag || (evol.maxFES > 0 && evol.currentFES+evol.size > evol.maxFES)
-	flag = flag || (evol.hof.Len() > 0 && evol.hof.Get(0).GetFitness().GetValues()[0] < 1E-14)
-	return
-}
-
 // Evolve runs the evol a time/generation per call and return generation time
 func (evol *PSO) Evolve() interface{} {
 	evol.gen++
 	if !evol.IsTerminated() {
+		if evol.dynamic != nil {
+			evol.detectChange()
+		}
 		// create offsprings (only clone)
 		offsprings := make(base.Individuals, evol.size)
-		gBest := evol.hof.Get(0)
-		tGBest := gBest
+		nbests := neighbourhoodBests(evol.population, evol.topology, particlePBest)
 		for i, ind := range evol.population {
 			cInd := ind.Clone().(*Particle)
 			speed := cInd.GetSpeed()
 			chrom := cInd.GetChromosome().([]float64)
 			pChrom := cInd.GetPBest().GetChromosome().([]float64)
-			gChrom := gBest.GetChromosome().([]float64)
-			for j := range speed {
-				speed[j] += evol.c1*rand.Float64()*(pChrom[j]-chrom[j]) + evol.c2*rand.Float64()*(gChrom[j]-chrom[j])
-				chrom[j] += speed[j]
-			}
+			nChrom := nbests[i].GetChromosome().([]float64)
+			evol.velocity(evol.gen, evol.maxGen, speed, chrom, pChrom, nChrom)
 			cInd.SetSpeed(speed)
+			for j, v := range cInd.GetSpeed() {
+				chrom[j] += v
+			}
+			if evol.bounds != nil {
+				evol.bounds.Repair(chrom, ind.GetChromosome().([]float64))
+			}
 			cInd.GetFitness().SetValues(evol.evaluator(&cInd.Float64Individual))
-			if cInd.GetFitness().Greater(ind.GetFitness()) {
-				if cInd.GetFitness().Greater(cInd.GetPBest().GetFitness()) {
-					cInd.SetPBest(cInd)
-					if cInd.GetFitness().Greater(tGBest.GetFitness()) {
-						tGBest = cInd
-					}
-				}
-				offsprings[i] = cInd
-			} else {
+			if evol.greedy && !cInd.GetFitness().Greater(ind.GetFitness()) {
 				offsprings[i] = ind.Clone().(*Particle)
+				continue
+			}
+			if cInd.GetFitness().Greater(cInd.GetPBest().GetFitness()) {
+				cInd.SetPBest(cInd)
 			}
+			offsprings[i] = cInd
 		}
-		gBest = tGBest
 		evol.population = offsprings
 		evol.currentFES += evol.size
 		// log
+		best := evol.hof.Get(0).GetFitness().Clone()
 		evol.log()
+		evol.topology.Update(evol.size, evol.hof.Get(0).GetFitness().Greater(best))
 	}
 	return evol.gen
 }
@@ -115,36 +155,55 @@ func (evol *PSO) Run() {
 	}
 }
 
-// GetLogbook returns the logbook saving data
-func (evol *PSO) GetLogbook() support.Logbook {
-	return evol.logbook
-}
-
-// GetHallOfFame returns the HallOfFame saving best individuals
-func (evol *PSO) GetHallOfFame() support.HallOfFame {
-	return evol.hof
-}
-
-func (evol *PSO) evaluate(Individuals base.Individuals) {
+// detectChange re-evaluates the sentinels and responds to the change of the environment
+func (evol *PSO) detectChange() {
+	evaluate := func(ind base.Individual) []float64 {
+		return evol.evaluator(&ind.(*Particle).Float64Individual)
+	}
+	changed, fes := evol.dynamic.Detect(evaluate)
+	evol.currentFES += fes
+	if !changed {
+		return
+	}
+	evol.dynamic.Respond(evol.population)
+	evol.currentFES += dynamic.Reevaluate(evol.population, evaluate)
+	// the pbests are shared by the clones, so each of them is re-evaluated once
+	pbests := make(map[*Particle]bool)
 	for _, ind := range evol.population {
-		fInd := ind.(*Particle)
-		fit := evol.evaluator(&fInd.Float64Individual)
-		fInd.GetFitness().SetValues(fit)
-		fInd.SetPBest(fInd)
+		part := ind.(*Particle)
+		pbest := part.GetPBest()
+		if pbest == part {
+			continue
+		}
+		if !pbests[pbest] {
+			pbest.GetFitness().SetValues(evaluate(pbest))
+			evol.currentFES++
+			pbests[pbest] = true
+		}
+		if !pbest.GetFitness().Greater(part.GetFitness()) {
+			part.SetPBest(part)
+		}
 	}
-	evol.currentFES += evol.size
+	evol.currentFES += dynamic.ReevaluateHallOfFame(evol.hof, evol.population, evaluate)
+	evol.dynamic.Watch(evol.population)
 }
 
-func (evol *PSO) log() {
-	var datas support.Dict
-	if evol.stat != nil {
-		datas = evol.stat.Compile(evol.population)
-	} else {
-		datas = make(support.Dict, 3)
+// neighbourhoodBests returns the best pbest in the neighbourhood of each particle, pbest returns the pbest of a particle
+func neighbourhoodBests(population base.Individuals, topology Topology, pbest func(base.Individual) base.Individual) base.Individuals {
+	size := population.Len()
+	nbests := make(base.Individuals, size)
+	for i := range population {
+		for _, j := range topology.Neighbours(i, size) {
+			best := pbest(population[j])
+			if nbests[i] == nil || best.GetFitness().Greater(nbests[i].GetFitness()) {
+				nbests[i] = best
+			}
+		}
 	}
-	datas[support.GEN] = evol.gen
-	datas[support.FES] = evol.size
-	evol.logbook.Record(datas)
+	return nbests
+}
 
-	evol.hof.Update(evol.population)
+// particlePBest returns the pbest of a Particle
+func particlePBest(ind base.Individual) base.Individual {
+	return ind.(*Particle).GetPBest()
 }
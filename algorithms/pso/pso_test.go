@@ -7,6 +7,7 @@ import (
 
 	"github.com/sineatos/deag/base"
 	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
 	"github.com/sineatos/deag/tools/constraint"
 	"github.com/sineatos/deag/tools/inits"
 	"github.com/sineatos/deag/tools/support"
@@ -88,3 +89,79 @@ func TestPSO(t *testing.T) {
 		t.Log(hof.Get(i))
 	}
 }
+
+func TestPSOGreedy(t *testing.T) {
+	size, dims := 10, 2
+	low, up := -6.0, 6.0
+	pop := newPSOPopulation(size, dims, low, up, -3.0, 3.0)
+	evol := NewPSO(2.0, 2.0, 50, math.MaxInt64, nil, support.NewDefaultHallOfFame(1, nil), benchmarks.H1, NewRingTopology(1))
+	if _, ok := evol.topology.(*RingTopology); !ok {
+		t.Fatalf("NewPSO should use the given topology: %T", evol.topology)
+	}
+	evol.Init(pop)
+	for !evol.IsTerminated() {
+		previous := evol.GetPopulation()
+		evol.Evolve()
+		// a particle never moves to a worse position
+		for i, ind := range evol.GetPopulation() {
+			if ind.GetFitness().Less(previous[i].GetFitness()) {
+				t.Fatalf("the particle %d moves to a worse position: %v -> %v", i, previous[i], ind)
+			}
+		}
+	}
+}
+
+func TestPSOWithBounds(t *testing.T) {
+	c1, c2 := 2.0, 2.0
+	maxGen, maxFES := 100, math.MaxInt64
+	size, dims := 10, 2
+	low, up := -6.0, 6.0
+	smin, smax := -3.0, 3.0
+	b := bounds.NewBounds(low, up, bounds.Reflect, dims)
+	pop := newPSOPopulation(size, dims, low, up, smin, smax)
+	evol := NewPSO(c1, c2, maxGen, maxFES, nil, support.NewDefaultHallOfFame(1, nil), benchmarks.H1)
+	evol.SetBounds(b)
+	evol.Init(pop)
+	evol.Run()
+	for _, ind := range evol.population {
+		if !b.Contains(ind.GetChromosome().([]float64)) {
+			t.Errorf("particle is out of bounds: %v", ind)
+		}
+	}
+	t.Log(evol.GetHallOfFame().Get(0))
+}
+
+func TestPSOTopologies(t *testing.T) {
+	maxGen, maxFES := 100, math.MaxInt64
+	size, dims := 20, 2
+	low, up := -6.0, 6.0
+	smin, smax := -3.0, 3.0
+	topologies := map[string]Topology{
+		"global":      NewGlobalTopology(),
+		"star":        NewStarTopology(),
+		"ring":        NewRingTopology(1),
+		"von neumann": NewVonNeumannTopology(),
+		"random":      NewRandomTopology(3),
+		"wheel":       NewWheelTopology(),
+	}
+	velocities := map[string]VelocityUpdate{
+		"linear decreasing inertia": InertiaVelocity(2.0, 2.0, LinearDecreasingInertia(0.9, 0.4)),
+		"constriction":              ConstrictionVelocity(2.05, 2.05),
+	}
+	for tName, topology := range topologies {
+		for vName, velocity := range velocities {
+			pop := newPSOPopulation(size, dims, low, up, smin, smax)
+			evol := NewPSOWithTopology(velocity, topology, maxGen, maxFES, nil, nil, benchmarks.H1)
+			evol.SetBounds(bounds.NewBounds(low, up, bounds.Clip, dims))
+			evol.Init(pop)
+			evol.Run()
+			for _, ind := range evol.GetPopulation() {
+				part := ind.(*Particle)
+				if part.GetPBest().GetFitness().Less(part.GetFitness()) {
+					t.Errorf("%s/%s: pbest is worse than the particle: %v", tName, vName, part)
+				}
+			}
+			t.Logf("%s/%s: %v", tName, vName, evol.GetHallOfFame().Get(0))
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package pso
+
+import (
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/dynamic"
+	"github.com/sineatos/deag/tools/support"
+)
+
+// swarm keeps the data shared by the particle swarm algorithms
+type swarm struct {
+	population base.Individuals
+	stat       support.Statistics
+	hof        support.HallOfFame
+	logbook    support.Logbook
+	size       int
+	maxFES     int
+	currentFES int
+	gen        int
+	maxGen     int
+	// dynamic is the Handler of the dynamic optimization, optional
+	dynamic *dynamic.Handler
+}
+
+// reset prepares the swarm for population
+func (s *swarm) reset(population base.Individuals) {
+	s.currentFES = 0
+	s.gen = 0
+	s.logbook = support.NewDefaultLogbook(s.maxGen, 0)
+	s.population = population
+	s.size = population.Len()
+
+	if s.hof == nil {
+		s.hof = support.NewDefaultHallOfFame(1, nil)
+	}
+}
+
+// IsTerminated returns if the evolution is terminated.
+// A minimization (negative weight) also stops when the best value is less than 1E-14, a maximization only stops by maxGen and maxFES.
+func (s *swarm) IsTerminated() (flag bool) {
+	flag = s.gen >= s.maxGen
+	flag = flag || (s.maxFES > 0 && s.currentFES+s.size > s.maxFES)
+	if !flag && s.hof.Len() > 0 {
+		fitness := s.hof.Get(0).GetFitness()
+		flag = fitness.GetWeights()[0] < 0.0 && fitness.GetValues()[0] < 1E-14
+	}
+	return
+}
+
+// GetLogbook returns the logbook saving data
+func (s *swarm) GetLogbook() support.Logbook {
+	return s.logbook
+}
+
+// GetHallOfFame returns the HallOfFame saving best individuals
+func (s *swarm) GetHallOfFame() support.HallOfFame {
+	return s.hof
+}
+
+// GetPopulation returns the current swarm
+func (s *swarm) GetPopulation() base.Individuals {
+	return s.population
+}
+
+func (s *swarm) log() {
+	s.logIndividuals(s.population)
+}
+
+// logIndividuals records the statistics of individuals and updates the HallOfFame by them
+func (s *swarm) logIndividuals(individuals base.Individuals) {
+	var datas support.Dict
+	if s.stat != nil {
+		datas = s.stat.Compile(individuals)
+	} else {
+		datas = make(support.Dict, 3)
+	}
+	datas[support.GEN] = s.gen
+	datas[support.FES] = s.size
+	if s.dynamic != nil {
+		s.dynamic.Record(datas)
+	}
+	s.logbook.Record(datas)
+
+	s.hof.Update(individuals)
+}
@@ -0,0 +1,177 @@
+package pso
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Topology defines the neighbourhood of each particle, the best pbest in the neighbourhood guides the particle
+type Topology interface {
+	// Neighbours returns the indexes of the neighbours of particle i in a swarm of size particles, the particle itself is included
+	Neighbours(i, size int) []int
+	// Update is called after each generation, improved reports if the best fitness of the swarm is improved in this generation
+	Update(size int, improved bool)
+}
+
+// GlobalTopology is the gbest topology (the star topology of the PSO literature), every particle is the neighbour of each other
+type GlobalTopology struct{}
+
+// NewGlobalTopology returns *GlobalTopology
+func NewGlobalTopology() *GlobalTopology {
+	return &GlobalTopology{}
+}
+
+// NewStarTopology returns *GlobalTopology, which is the star topology of the PSO literature
+func NewStarTopology() *GlobalTopology {
+	return NewGlobalTopology()
+}
+
+// Neighbours returns all the particles
+func (topo *GlobalTopology) Neighbours(i, size int) []int {
+	neighbours := make([]int, size)
+	for j := range neighbours {
+		neighbours[j] = j
+	}
+	return neighbours
+}
+
+// Update does nothing because GlobalTopology is static
+func (topo *GlobalTopology) Update(size int, improved bool) {}
+
+// RingTopology is the lbest topology, the particles are arranged in a ring and each particle is connected to k particles on each side
+type RingTopology struct {
+	k int
+}
+
+// NewRingTopology returns *RingTopology, k is the number of neighbours on each side, the classical lbest uses k=1
+func NewRingTopology(k int) *RingTopology {
+	return &RingTopology{k: k}
+}
+
+// Neighbours returns the particles whose distances to particle i in the ring are not greater than k
+func (topo *RingTopology) Neighbours(i, size int) []int {
+	k := topo.k
+	if 2*k+1 > size {
+		k = (size - 1) / 2
+	}
+	neighbours := make([]int, 0, 2*k+1)
+	for d := -k; d <= k; d++ {
+		neighbours = append(neighbours, ((i+d)%size+size)%size)
+	}
+	return neighbours
+}
+
+// Update does nothing because RingTopology is static
+func (topo *RingTopology) Update(size int, improved bool) {}
+
+// VonNeumannTopology arranges the particles in a toroidal grid, each particle is connected to the particles above, below, left and right.
+// The grid has r rows and size/r columns, where r is the greatest divisor of size not greater than sqrt(size).
+type VonNeumannTopology struct{}
+
+// NewVonNeumannTopology returns *VonNeumannTopology
+func NewVonNeumannTopology() *VonNeumannTopology {
+	return &VonNeumannTopology{}
+}
+
+// Neighbours returns particle i and its four neighbours in the grid
+func (topo *VonNeumannTopology) Neighbours(i, size int) []int {
+	rows := int(math.Sqrt(float64(size)))
+	for size%rows != 0 {
+		rows--
+	}
+	cols := size / rows
+	r, c := i/cols, i%cols
+	candidates := []int{
+		i,
+		((r+rows-1)%rows)*cols + c,
+		((r+1)%rows)*cols + c,
+		r*cols + (c+cols-1)%cols,
+		r*cols + (c+1)%cols,
+	}
+	// remove the duplicates of small grids
+	neighbours := make([]int, 0, len(candidates))
+	for _, x := range candidates {
+		duplicated := false
+		for _, y := range neighbours {
+			if x == y {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			neighbours = append(neighbours, x)
+		}
+	}
+	return neighbours
+}
+
+// Update does nothing because VonNeumannTopology is static
+func (topo *VonNeumannTopology) Update(size int, improved bool) {}
+
+// RandomTopology is the adaptive random topology of SPSO 2007, each particle informs itself and k random particles.
+// The links are regenerated after a generation which doesn't improve the best fitness of the swarm.
+type RandomTopology struct {
+	k     int
+	links [][]int
+}
+
+// NewRandomTopology returns *RandomTopology, k is the number of informed particles of each particle, SPSO 2007 uses k=3
+func NewRandomTopology(k int) *RandomTopology {
+	return &RandomTopology{k: k}
+}
+
+// Neighbours returns the particles informing particle i
+func (topo *RandomTopology) Neighbours(i, size int) []int {
+	if len(topo.links) != size {
+		topo.generate(size)
+	}
+	return topo.links[i]
+}
+
+// Update regenerates the links if the swarm is not improved
+func (topo *RandomTopology) Update(size int, improved bool) {
+	if !improved || len(topo.links) != size {
+		topo.generate(size)
+	}
+}
+
+func (topo *RandomTopology) generate(size int) {
+	informed := make([][]bool, size)
+	for i := range informed {
+		informed[i] = make([]bool, size)
+		informed[i][i] = true
+	}
+	for i := 0; i < size; i++ {
+		for n := 0; n < topo.k; n++ {
+			informed[rand.Intn(size)][i] = true
+		}
+	}
+	topo.links = make([][]int, size)
+	for i, row := range informed {
+		topo.links[i] = make([]int, 0, topo.k+1)
+		for j, ok := range row {
+			if ok {
+				topo.links[i] = append(topo.links[i], j)
+			}
+		}
+	}
+}
+
+// WheelTopology (also called the focal topology) connects every particle to a focal particle (the first one), the focal particle is connected to all the particles
+type WheelTopology struct{}
+
+// NewWheelTopology returns *WheelTopology
+func NewWheelTopology() *WheelTopology {
+	return &WheelTopology{}
+}
+
+// Neighbours returns all the particles for the focal particle, and returns particle i and the focal particle for the others
+func (topo *WheelTopology) Neighbours(i, size int) []int {
+	if i == 0 {
+		return NewGlobalTopology().Neighbours(i, size)
+	}
+	return []int{0, i}
+}
+
+// Update does nothing because WheelTopology is static
+func (topo *WheelTopology) Update(size int, improved bool) {}
@@ -0,0 +1,65 @@
+package pso
+
+import (
+	"sort"
+	"testing"
+)
+
+func checkNeighbours(t *testing.T, name string, target, neighbours []int) {
+	sorted := make([]int, len(neighbours))
+	copy(sorted, neighbours)
+	sort.Ints(sorted)
+	if len(sorted) != len(target) {
+		t.Errorf("%s: expected %v, got %v", name, target, sorted)
+		return
+	}
+	for i := range target {
+		if sorted[i] != target[i] {
+			t.Errorf("%s: expected %v, got %v", name, target, sorted)
+			return
+		}
+	}
+}
+
+func TestTopologies(t *testing.T) {
+	size := 12
+	checkNeighbours(t, "global", []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, NewGlobalTopology().Neighbours(3, size))
+	checkNeighbours(t, "star", []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, NewStarTopology().Neighbours(3, size))
+	checkNeighbours(t, "ring(1)", []int{0, 1, 11}, NewRingTopology(1).Neighbours(0, size))
+	checkNeighbours(t, "ring(2)", []int{3, 4, 5, 6, 7}, NewRingTopology(2).Neighbours(5, size))
+	// 3 x 4 grid
+	checkNeighbours(t, "von neumann", []int{0, 1, 3, 4, 8}, NewVonNeumannTopology().Neighbours(0, size))
+	checkNeighbours(t, "von neumann", []int{1, 4, 5, 6, 9}, NewVonNeumannTopology().Neighbours(5, size))
+	checkNeighbours(t, "wheel(hub)", []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, NewWheelTopology().Neighbours(0, size))
+	checkNeighbours(t, "wheel", []int{0, 7}, NewWheelTopology().Neighbours(7, size))
+
+	random := NewRandomTopology(3)
+	first := random.Neighbours(4, size)
+	found := false
+	for _, j := range first {
+		found = found || j == 4
+	}
+	if !found || len(first) > size {
+		t.Errorf("random: particle should inform itself: %v", first)
+	}
+	random.Update(size, true)
+	checkNeighbours(t, "random (improved)", first, random.Neighbours(4, size))
+}
+
+func TestVelocityUpdates(t *testing.T) {
+	w := LinearDecreasingInertia(0.9, 0.4)
+	if w(0, 100) != 0.9 || w(100, 100) != 0.4 {
+		t.Errorf("linear decreasing inertia: %v %v", w(0, 100), w(100, 100))
+	}
+	speed := []float64{1.0, -1.0}
+	pos := []float64{0.0, 0.0}
+	InertiaVelocity(0.0, 0.0, ConstantInertia(0.5))(0, 10, speed, pos, pos, pos)
+	if speed[0] != 0.5 || speed[1] != -0.5 {
+		t.Errorf("inertia velocity: %v", speed)
+	}
+	speed = []float64{1.0, -1.0}
+	ConstrictionVelocity(2.05, 2.05)(0, 10, speed, pos, pos, pos)
+	if speed[0] < 0.7297 || speed[0] > 0.7299 {
+		t.Errorf("constriction factor should be 0.7298: %v", speed)
+	}
+}
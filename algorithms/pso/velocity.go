@@ -0,0 +1,66 @@
+package pso
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// InertiaWeight returns the inertia weight used in generation gen
+type InertiaWeight func(gen, maxGen int) float64
+
+// ConstantInertia returns an InertiaWeight which is always w
+func ConstantInertia(w float64) InertiaWeight {
+	return func(gen, maxGen int) float64 {
+		return w
+	}
+}
+
+// LinearDecreasingInertia returns an InertiaWeight decreasing linearly from wStart to wEnd during maxGen generations, typical values are 0.9 and 0.4 [Shi1998]
+//
+// [Shi1998] Shi and Eberhart, "A modified particle swarm optimizer", 1998.
+func LinearDecreasingInertia(wStart, wEnd float64) InertiaWeight {
+	return func(gen, maxGen int) float64 {
+		if maxGen <= 0 {
+			return wStart
+		}
+		return wStart - (wStart-wEnd)*float64(gen)/float64(maxGen)
+	}
+}
+
+// VelocityUpdate updates speed in place, pos is the position of the particle, pbest is its personal best position and nbest is the best position of its neighbourhood
+type VelocityUpdate func(gen, maxGen int, speed, pos, pbest, nbest []float64)
+
+// InertiaVelocity returns the VelocityUpdate with inertia weight:
+//
+// v = w*v + c1*r1*(pbest - x) + c2*r2*(nbest - x)
+//
+// c1 is coefficient of local information, c2 is coefficient of neighbourhood information, ConstantInertia(1.0) gives the original PSO.
+func InertiaVelocity(c1, c2 float64, w InertiaWeight) VelocityUpdate {
+	return func(gen, maxGen int, speed, pos, pbest, nbest []float64) {
+		weight := w(gen, maxGen)
+		for j := range speed {
+			speed[j] = weight*speed[j] + c1*rand.Float64()*(pbest[j]-pos[j]) + c2*rand.Float64()*(nbest[j]-pos[j])
+		}
+	}
+}
+
+// ConstrictionVelocity returns the VelocityUpdate with Clerc's constriction factor [Clerc2002]:
+//
+// v = chi*(v + c1*r1*(pbest - x) + c2*r2*(nbest - x)), chi = 2/|2 - phi - sqrt(phi^2 - 4phi)|, phi = c1 + c2 > 4
+//
+// The common setting is c1 = c2 = 2.05, which gives chi = 0.7298.
+//
+// [Clerc2002] Clerc and Kennedy, "The particle swarm - explosion, stability, and convergence in a multidimensional complex space", 2002.
+func ConstrictionVelocity(c1, c2 float64) VelocityUpdate {
+	phi := c1 + c2
+	if phi <= 4.0 {
+		panic(fmt.Sprintf("c1 + c2 must be greater than 4 when using constriction factor: %v", phi))
+	}
+	chi := 2.0 / math.Abs(2.0-phi-math.Sqrt(phi*phi-4.0*phi))
+	return func(gen, maxGen int, speed, pos, pbest, nbest []float64) {
+		for j := range speed {
+			speed[j] = chi * (speed[j] + c1*rand.Float64()*(pbest[j]-pos[j]) + c2*rand.Float64()*(nbest[j]-pos[j]))
+		}
+	}
+}
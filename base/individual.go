@@ -33,6 +33,19 @@ type ESIndividual interface {
 	SetStrategies(strategies []float64)
 }
 
+// DEIndividual is a Individual type which carries the control parameters of differential evolution
+type DEIndividual interface {
+	Individual
+	// GetF returns the scale factor
+	GetF() float64
+	// SetF sets the scale factor
+	SetF(f float64)
+	// GetCR returns the crossover rate
+	GetCR() float64
+	// SetCR sets the crossover rate
+	SetCR(cr float64)
+}
+
 // Len returns the size of Individuals
 func (inds Individuals) Len() int {
 	return len(inds)
@@ -149,3 +149,65 @@ func (ind *Float64ESIndividual) String() string {
 	fmtStr := "Float64ESIndividual{chromosome:%v, fitness:%v, strategies:%v}"
 	return fmt.Sprintf(fmtStr, ind.chromosome, ind.fitness, ind.strategies)
 }
+
+// Float64DEIndividual handles float64 type information and carries its own scale factor and crossover rate
+type Float64DEIndividual struct {
+	// Float64Individual
+	Float64Individual
+	// scale factor
+	f float64
+	// crossover rate
+	cr float64
+}
+
+// NewFloat64DEIndividual returns a Float64DEIndividual
+func NewFloat64DEIndividual(chromosome []float64, f, cr float64, fitness *Fitness) *Float64DEIndividual {
+	return &Float64DEIndividual{Float64Individual: *NewFloat64Individual(chromosome, fitness), f: f, cr: cr}
+}
+
+// Clone returns an copy of Individual
+func (ind *Float64DEIndividual) Clone() interface{} {
+	f64Ind := ind.Float64Individual.Clone().(*Float64Individual)
+	return &Float64DEIndividual{Float64Individual: *f64Ind, f: ind.f, cr: ind.cr}
+}
+
+// GetF returns the scale factor
+func (ind *Float64DEIndividual) GetF() float64 {
+	return ind.f
+}
+
+// SetF sets the scale factor
+func (ind *Float64DEIndividual) SetF(f float64) {
+	ind.f = f
+}
+
+// GetCR returns the crossover rate
+func (ind *Float64DEIndividual) GetCR() float64 {
+	return ind.cr
+}
+
+// SetCR sets the crossover rate
+func (ind *Float64DEIndividual) SetCR(cr float64) {
+	ind.cr = cr
+}
+
+// IsEqual returns if the other individual is equal to the individual, the control parameters are not compared
+func (ind *Float64DEIndividual) IsEqual(other Individual) bool {
+	if otherInd, ok1 := other.(*Float64DEIndividual); ok1 {
+		chrom := otherInd.chromosome
+		if len(chrom) == len(ind.chromosome) {
+			for i, c := range ind.chromosome {
+				if math.Abs(chrom[i]-c) > 1E-14 {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func (ind *Float64DEIndividual) String() string {
+	fmtStr := "Float64DEIndividual{chromosome:%v, fitness:%v, f:%v, cr:%v}"
+	return fmt.Sprintf(fmtStr, ind.chromosome, ind.fitness, ind.f, ind.cr)
+}
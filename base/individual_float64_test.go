@@ -45,3 +45,32 @@ func TestFloat64ESIndividual(t *testing.T) {
 	ind3.SetChromosome(ind2.GetChromosome())
 	t.Log(ind3)
 }
+
+func TestFloat64DEIndividual(t *testing.T) {
+	ind1 := NewFloat64DEIndividual([]float64{1.0, 2.0, 2.0}, 0.5, 0.9, NewFitness([]float64{-1.0}))
+	ind2 := NewFloat64DEIndividual([]float64{10.2, 2.0, 80.0}, 0.1, 0.2, NewFitness([]float64{-1.0}))
+	ind3 := NewFloat64DEIndividual([]float64{1.0, 2.0, 2.0}, 0.7, 0.3, NewFitness([]float64{-1.0}))
+	ind4 := ind1.Clone().(*Float64DEIndividual)
+	if !ind1.IsEqual(ind3) {
+		t.Errorf("ind1 isn't equal to ind3: %v %v", ind1, ind3)
+	}
+	if !ind3.IsEqual(ind4) {
+		t.Errorf("ind3 isn't equal to ind4: %v %v", ind3, ind4)
+	}
+	if ind1.IsEqual(ind2) {
+		t.Errorf("ind1 is equal to ind2: %v %v", ind1, ind2)
+	}
+	if ind4.GetF() != ind1.GetF() || ind4.GetCR() != ind1.GetCR() {
+		t.Errorf("control parameters aren't cloned: %v %v", ind1, ind4)
+	}
+	ind4.SetF(0.3)
+	ind4.SetCR(0.4)
+	if ind1.GetF() != 0.5 || ind1.GetCR() != 0.9 {
+		t.Errorf("clone shares control parameters with ind1: %v %v", ind1, ind4)
+	}
+	t.Log(ind1)
+	t.Log(ind2)
+	t.Log(ind4)
+	ind3.SetChromosome(ind2.GetChromosome())
+	t.Log(ind3)
+}
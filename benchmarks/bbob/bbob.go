@@ -0,0 +1,152 @@
+// Package bbob implements the 24 noiseless functions of the black-box optimization benchmarking (BBOB) [Hansen2009],
+// and the bookkeeping of the target precisions and the data files of the COCO platform [Hansen2021].
+//
+// All the functions are minimized in [-5, 5]^D. An instance of a function is generated from the function number and the instance number
+// by the pseudo random generator of BBOB 2009, including the optimal solution xopt, the optimal value fopt and the rotations.
+//
+// [Hansen2009] Hansen, Finck, Ros and Auger, "Real-parameter black-box optimization benchmarking 2009: noiseless functions definitions", 2009.
+//
+// [Hansen2021] Hansen, Auger, Ros, Mersmann, Tusar and Brockhoff, "COCO: a platform for comparing continuous optimizers in a black-box setting", 2021.
+package bbob
+
+import (
+	"fmt"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+)
+
+const (
+	// LowerBound is the lower bound of the search space in each dimension
+	LowerBound float64 = -5.0
+	// UpperBound is the upper bound of the search space in each dimension
+	UpperBound float64 = 5.0
+	// Functions is the number of the noiseless functions
+	Functions int = 24
+)
+
+// FunctionNames are the names of f1-f24
+var FunctionNames = [Functions]string{
+	"Sphere",
+	"Ellipsoidal",
+	"Rastrigin",
+	"Bueche-Rastrigin",
+	"Linear Slope",
+	"Attractive Sector",
+	"Step Ellipsoidal",
+	"Rosenbrock, original",
+	"Rosenbrock, rotated",
+	"Ellipsoidal, rotated",
+	"Discus",
+	"Bent Cigar",
+	"Sharp Ridge",
+	"Different Powers",
+	"Rastrigin, rotated",
+	"Weierstrass",
+	"Schaffers F7",
+	"Schaffers F7, ill-conditioned",
+	"Composite Griewank-Rosenbrock F8F2",
+	"Schwefel x*sin(x)",
+	"Gallagher's Gaussian 101-me Peaks",
+	"Gallagher's Gaussian 21-hi Peaks",
+	"Katsuura",
+	"Lunacek bi-Rastrigin",
+}
+
+// Problem is an instance of a BBOB function in a dimension
+type Problem struct {
+	function int
+	instance int
+	dim      int
+	xopt     []float64
+	fopt     float64
+	value    func(x []float64) float64
+}
+
+// NewProblem returns *Problem of the instance of the function (1-24) in dim (at least 2) dimensions.
+// The same function, instance and dim always give the same Problem.
+func NewProblem(function, instance, dim int) *Problem {
+	if function < 1 || function > Functions {
+		panic(fmt.Sprintf("The function should be in [1, %d]: %d", Functions, function))
+	}
+	if instance < 1 {
+		panic(fmt.Sprintf("The instance should be positive: %d", instance))
+	}
+	if dim < 2 {
+		panic(fmt.Sprintf("The dimensions should be at least 2: %d", dim))
+	}
+	value, xopt := builders[function-1](dim, instanceSeed(function, instance))
+	return &Problem{
+		function: function,
+		instance: instance,
+		dim:      dim,
+		xopt:     xopt,
+		fopt:     computeFOpt(function, instance),
+		value:    value,
+	}
+}
+
+// GetFunction returns the function number
+func (p *Problem) GetFunction() int {
+	return p.function
+}
+
+// GetInstance returns the instance number
+func (p *Problem) GetInstance() int {
+	return p.instance
+}
+
+// Len returns the number of dimensions
+func (p *Problem) Len() int {
+	return p.dim
+}
+
+// GetName returns the name of the problem in the form of COCO, such as "bbob_f001_i01_d10"
+func (p *Problem) GetName() string {
+	return fmt.Sprintf("bbob_f%03d_i%02d_d%02d", p.function, p.instance, p.dim)
+}
+
+// GetXOpt returns a copy of the optimal solution
+func (p *Problem) GetXOpt() []float64 {
+	ans := make([]float64, p.dim)
+	copy(ans, p.xopt)
+	return ans
+}
+
+// GetFOpt returns the optimal value
+func (p *Problem) GetFOpt() float64 {
+	return p.fopt
+}
+
+// Value returns the value of x, x should have Len() components
+func (p *Problem) Value(x []float64) float64 {
+	if len(x) != p.dim {
+		panic(fmt.Sprintf("The dimensions of x should be %d: %d", p.dim, len(x)))
+	}
+	return p.value(x) + p.fopt
+}
+
+// Evaluate is the benchmarks.Float64Evaluator of Problem
+func (p *Problem) Evaluate(individual *base.Float64Individual) []float64 {
+	return []float64{p.Value(individual.GetChromosome().([]float64))}
+}
+
+func (p *Problem) String() string {
+	return fmt.Sprintf("%s: f%d %s, fopt=%.2f", p.GetName(), p.function, FunctionNames[p.function-1], p.fopt)
+}
+
+// init registers the instance 1 of f1-f24 as the benchmarks "bbob_f001" to "bbob_f024" of at least 2 dimensions in [-5, 5]^D,
+// the Problem of each dimension is built at its first use
+func init() {
+	for function := 1; function <= Functions; function++ {
+		function := function
+		problems := benchmarks.PerDimension(func(dim int) interface{} { return NewProblem(function, 1, dim) })
+		problem := func(dim int) *Problem { return problems(dim).(*Problem) }
+		benchmarks.RegisterBenchmark(benchmarks.NewBenchmark(fmt.Sprintf("bbob_f%03d", function),
+			benchmarks.Float64Evaluator(func(individual *base.Float64Individual) []float64 {
+				return problem(individual.Len()).Evaluate(individual)
+			}), 1, false).SetDims(2, 0, nil).SetBounds(benchmarks.UniformBounds(LowerBound, UpperBound)).
+			SetOptimum(func(dim int) []float64 { return []float64{problem(dim).GetFOpt()} },
+				func(dim int) interface{} { return problem(dim).GetXOpt() }))
+	}
+}
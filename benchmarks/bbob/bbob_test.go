@@ -0,0 +1,143 @@
+package bbob
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+)
+
+// fopts are the optimal values of the first instances of f1-f24 in COCO
+var fopts = [Functions]float64{
+	79.48, -209.88, -462.09, -462.09, -9.21, 35.90, 92.94, 149.15, 123.83, -54.94, 76.27, -621.11,
+	29.97, -52.35, 1000.0, 71.35, -16.94, -16.94, -102.55, -546.50, 40.78, -1000.0, 6.87, 102.61,
+}
+
+func TestFOpt(t *testing.T) {
+	for f := 1; f <= Functions; f++ {
+		if fopt := NewProblem(f, 1, 2).GetFOpt(); math.Abs(fopt-fopts[f-1]) > 1E-10 {
+			t.Errorf("f%d: expected fopt %v, got %v", f, fopts[f-1], fopt)
+		}
+	}
+}
+
+// TestReference compares the values of the first instances of f1-f24 in 5 dimensions with the values of the reference implementation of COCO at a fixed point
+func TestReference(t *testing.T) {
+	x := []float64{1.0, -2.0, 0.5, 3.0, -4.5}
+	targets := [Functions]float64{
+		86.70957568, 35199781.95890631, 88.86718338797908, 87.38420161274922,
+		37.71503184483715, 7446.460270656962, 794.2021797545117, 5527.151534004402,
+		53941.24276493996, 35646601.719846174, 2065473.7901363757, 1902407449.6861029,
+		1962.9229515129216, -1.5863910705762692, 1520.4912229664972, 95.79790125438203,
+		-3.917972863111716, 66.98189552928086, -70.77275261156917, 194591.52466369641,
+		114.96987225305156, -913.9886494572901, 21.0248786471472, 237.19605174525185,
+	}
+	for f, target := range targets {
+		if v := NewProblem(f+1, 1, len(x)).Value(x); math.Abs(v-target) > 1E-8*math.Max(1.0, math.Abs(target)) {
+			t.Errorf("f%d: expected %v at %v, got %v", f+1, target, x, v)
+		}
+	}
+}
+
+func TestRotation(t *testing.T) {
+	for _, dim := range []int{2, 5, 20} {
+		r := rotation(dim, 12345)
+		for i := range r {
+			for j := range r {
+				prod := 0.0
+				for k := range r {
+					prod += r[k][i] * r[k][j]
+				}
+				target := 0.0
+				if i == j {
+					target = 1.0
+				}
+				if math.Abs(prod-target) > 1E-10 {
+					t.Errorf("the rotation isn't orthogonal in dim=%v: %v", dim, prod)
+				}
+			}
+		}
+	}
+	u1, u2 := unif(10, 42), unif(10, 42)
+	for i := range u1 {
+		if u1[i] != u2[i] || u1[i] <= 0.0 || u1[i] >= 1.0 {
+			t.Errorf("unif should be reproducible in (0, 1): %v, %v", u1, u2)
+		}
+	}
+}
+
+func TestOptimum(t *testing.T) {
+	for f := 1; f <= Functions; f++ {
+		for _, dim := range []int{2, 3, 5, 10, 20} {
+			for instance := 1; instance <= 3; instance++ {
+				p := NewProblem(f, instance, dim)
+				xopt := p.GetXOpt()
+				for _, v := range xopt {
+					if v < LowerBound || v > UpperBound {
+						t.Errorf("%v: xopt is out of bounds: %v", p, xopt)
+					}
+				}
+				if v := p.Value(xopt); math.Abs(v-p.GetFOpt()) > 1E-8 {
+					t.Errorf("%v: f(xopt) should be fopt: %v", p, v)
+				}
+				for k := 0; k < 100; k++ {
+					x := make([]float64, dim)
+					for i := range x {
+						x[i] = LowerBound + rand.Float64()*(UpperBound-LowerBound)
+					}
+					if v := p.Value(x); v < p.GetFOpt()-1E-8 {
+						t.Errorf("%v: the value is less than fopt: %v at %v", p, v, x)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestProblem(t *testing.T) {
+	p1, p2 := NewProblem(15, 2, 10), NewProblem(15, 2, 10)
+	if p1.GetName() != "bbob_f015_i02_d10" {
+		t.Errorf("wrong name: %v", p1.GetName())
+	}
+	x := make([]float64, 10)
+	for i := range x {
+		x[i] = rand.Float64()
+	}
+	ind := base.NewFloat64Individual(x, base.NewFitness([]float64{-1.0}))
+	if v1, v2 := p1.Evaluate(ind)[0], p2.Value(x); v1 != v2 {
+		t.Errorf("the same instance should give the same value: %v, %v", v1, v2)
+	}
+	if v := NewProblem(15, 3, 10).Value(x); v == p1.Value(x) {
+		t.Errorf("the different instances should give the different values: %v", v)
+	}
+}
+
+func TestRegisteredBenchmarks(t *testing.T) {
+	for function := 1; function <= Functions; function++ {
+		name := fmt.Sprintf("bbob_f%03d", function)
+		b, ok := benchmarks.LookupBenchmark(name)
+		if !ok {
+			t.Fatalf("%s should be registered", name)
+		}
+		if err := b.CheckDim(1); err == nil {
+			t.Errorf("%s shouldn't be defined for 1 variable", name)
+		}
+		for _, dim := range []int{2, 10} {
+			x := b.Sample(dim).([]float64)
+			if !b.InBounds(x) {
+				t.Errorf("%s: the random point should be in [-5, 5]: %v", name, x)
+			}
+			p := NewProblem(function, 1, dim)
+			if v := b.Evaluate(x); len(v) != 1 || v[0] != p.Value(x) {
+				t.Errorf("%s: the value should be the value of %s %v: %v", name, p.GetName(), p.Value(x), v)
+			}
+			optimum := b.GetOptimum(dim)[0]
+			if values := b.Evaluate(b.GetOptimizer(dim)); optimum != p.GetFOpt() || !b.IsSolved(values, dim, 1E-8*math.Max(1.0, math.Abs(optimum))) {
+				t.Errorf("%s: the optimizer should reach the optimum %v: %v", name, p.GetFOpt(), values)
+			}
+		}
+	}
+}
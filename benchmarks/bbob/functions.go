@@ -0,0 +1,468 @@
+package bbob
+
+import (
+	"math"
+)
+
+// builder builds the function of an instance from the dimensions and the seed of the instance,
+// it returns the function without the optimal value fopt and the optimal solution xopt
+type builder func(dim int, seed int64) (func(x []float64) float64, []float64)
+
+// builders are the builders of f1-f24
+var builders = []builder{
+	sphere, ellipsoidSeparable, rastriginSeparable, buecheRastrigin, linearSlope,
+	attractiveSector, stepEllipsoid, rosenbrockOriginal, rosenbrockRotated,
+	ellipsoidRotated, discus, bentCigar, sharpRidge, differentPowers,
+	rastriginRotated, weierstrass, schaffersF7, schaffersF7Ill, griewankRosenbrock,
+	schwefel, gallagher101, gallagher21, katsuura, lunacekBiRastrigin,
+}
+
+// rotationR returns the rotation R of the instance, which is generated with seed + 1000000
+func rotationR(dim int, seed int64) [][]float64 {
+	return rotation(dim, seed+1000000)
+}
+
+// rotationQ returns the rotation Q of the instance, which is generated with seed
+func rotationQ(dim int, seed int64) [][]float64 {
+	return rotation(dim, seed)
+}
+
+//*************************************************************
+// Separable functions
+//*************************************************************
+
+// f1: f(x) = ||z||^2, z = x - xopt
+func sphere(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt := computeXOpt(dim, seed)
+	return func(x []float64) float64 {
+		ans := 0.0
+		for _, v := range shift(x, xopt) {
+			ans += v * v
+		}
+		return ans
+	}, xopt
+}
+
+// f2: f(x) = sum 10^(6*i/(D-1)) * z_i^2, z = T_osz(x - xopt)
+func ellipsoidSeparable(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt := computeXOpt(dim, seed)
+	return func(x []float64) float64 {
+		return ellipsoid(tosz(shift(x, xopt)))
+	}, xopt
+}
+
+// f3: f(x) = 10*(D - sum cos(2*pi*z_i)) + ||z||^2, z = Λ^10 * T_asy^0.2(T_osz(x - xopt))
+func rastriginSeparable(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt := computeXOpt(dim, seed)
+	return func(x []float64) float64 {
+		return rastrigin(lambda(tasy(tosz(shift(x, xopt)), 0.2), 10.0))
+	}, xopt
+}
+
+// f4: f(x) = 10*(D - sum cos(2*pi*z_i)) + ||z||^2 + 100*fpen(x), z_i = s_i * T_osz(x_i - xopt_i),
+// s_i = 10 * 10^(0.5*i/(D-1)) for the positive z_i with even i, otherwise 10^(0.5*i/(D-1))
+func buecheRastrigin(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt := computeXOpt(dim, seed)
+	for i := 0; i < dim; i += 2 {
+		xopt[i] = math.Abs(xopt[i])
+	}
+	return func(x []float64) float64 {
+		z := tosz(shift(x, xopt))
+		for i, v := range z {
+			s := math.Pow(10.0, 0.5*ratio(i, dim))
+			if v > 0.0 && i%2 == 0 {
+				s *= 10.0
+			}
+			z[i] = s * v
+		}
+		return rastrigin(z) + 100.0*fpen(x)
+	}, xopt
+}
+
+// f5: f(x) = sum 5*|s_i| - s_i*z_i, s_i = sign(xopt_i) * 10^(i/(D-1)), z_i = x_i if x_i*xopt_i < 25 otherwise xopt_i,
+// the optimum is on the boundary xopt_i = ±5
+func linearSlope(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt := computeXOpt(dim, seed)
+	for i, v := range xopt {
+		xopt[i] = 5.0 * sign(v)
+	}
+	return func(x []float64) float64 {
+		ans := 0.0
+		for i, v := range x {
+			s := sign(xopt[i]) * math.Pow(10.0, ratio(i, dim))
+			z := v
+			if v*xopt[i] >= 25.0 {
+				z = xopt[i]
+			}
+			ans += 5.0*math.Abs(s) - s*z
+		}
+		return ans
+	}, xopt
+}
+
+//*************************************************************
+// Functions with low or moderate conditioning
+//*************************************************************
+
+// f6: f(x) = T_osz(sum (s_i*z_i)^2)^0.9, z = R * Λ^10 * Q * (x - xopt), s_i = 100 if z_i*xopt_i > 0 otherwise 1
+func attractiveSector(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt := computeXOpt(dim, seed)
+	m := conditioning(rotationR(dim, seed), 10.0, rotationQ(dim, seed))
+	return func(x []float64) float64 {
+		ans := 0.0
+		for i, v := range multiply(m, shift(x, xopt)) {
+			if v*xopt[i] > 0.0 {
+				v *= 100.0
+			}
+			ans += v * v
+		}
+		return math.Pow(oscillate(ans), 0.9)
+	}, xopt
+}
+
+// f7: f(x) = 0.1 * max(|zh_1|/10^4, sum 10^(2*i/(D-1)) * z_i^2) + fpen(x), zh = Λ^10 * Q * (x - xopt),
+// z = R * zt, zt_i = round(zh_i) if |zh_i| > 0.5 otherwise round(10*zh_i)/10
+func stepEllipsoid(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt := computeXOpt(dim, seed)
+	m := conditioning(nil, 10.0, rotationQ(dim, seed))
+	q := rotationR(dim, seed)
+	return func(x []float64) float64 {
+		zh := multiply(m, shift(x, xopt))
+		zt := make([]float64, dim)
+		for i, v := range zh {
+			if math.Abs(v) > 0.5 {
+				zt[i] = round(v)
+			} else {
+				zt[i] = round(10.0*v) / 10.0
+			}
+		}
+		ans := 0.0
+		for i, v := range multiply(q, zt) {
+			ans += math.Pow(10.0, 2.0*ratio(i, dim)) * v * v
+		}
+		return 0.1*math.Max(math.Abs(zh[0])/1E4, ans) + fpen(x)
+	}, xopt
+}
+
+// f8: f(x) = sum 100*(z_i^2 - z_(i+1))^2 + (z_i - 1)^2, z = max(1, sqrt(D)/8) * (x - xopt) + 1
+func rosenbrockOriginal(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt := computeXOpt(dim, seed)
+	for i := range xopt {
+		xopt[i] *= 0.75
+	}
+	factor := math.Max(1.0, math.Sqrt(float64(dim))/8.0)
+	return func(x []float64) float64 {
+		z := shift(x, xopt)
+		for i, v := range z {
+			z[i] = factor*v + 1.0
+		}
+		return rosenbrock(z)
+	}, xopt
+}
+
+// f9: f(x) = sum 100*(z_i^2 - z_(i+1))^2 + (z_i - 1)^2, z = max(1, sqrt(D)/8) * R * x + 1/2
+func rosenbrockRotated(dim int, seed int64) (func(x []float64) float64, []float64) {
+	r, factor := rotation(dim, seed), math.Max(1.0, math.Sqrt(float64(dim))/8.0)
+	return func(x []float64) float64 {
+		return rosenbrock(rosenbrockZ(r, factor, x))
+	}, rosenbrockXOpt(r, factor)
+}
+
+// rosenbrockZ returns factor * r * x + 1/2
+func rosenbrockZ(r [][]float64, factor float64, x []float64) []float64 {
+	z := multiply(r, x)
+	for i, v := range z {
+		z[i] = factor*v + 0.5
+	}
+	return z
+}
+
+// rosenbrockXOpt returns x which gives z = 1 in rosenbrockZ
+func rosenbrockXOpt(r [][]float64, factor float64) []float64 {
+	y := make([]float64, len(r))
+	for i := range y {
+		y[i] = 0.5 / factor
+	}
+	return multiply(transpose(r), y)
+}
+
+//*************************************************************
+// Functions with high conditioning and unimodal
+//*************************************************************
+
+// f10: f(x) = sum 10^(6*i/(D-1)) * z_i^2, z = T_osz(R * (x - xopt))
+func ellipsoidRotated(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt, r := computeXOpt(dim, seed), rotationR(dim, seed)
+	return func(x []float64) float64 {
+		return ellipsoid(tosz(multiply(r, shift(x, xopt))))
+	}, xopt
+}
+
+// f11: f(x) = 10^6 * z_1^2 + sum_(i>1) z_i^2, z = T_osz(R * (x - xopt))
+func discus(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt, r := computeXOpt(dim, seed), rotationR(dim, seed)
+	return func(x []float64) float64 {
+		z := tosz(multiply(r, shift(x, xopt)))
+		ans := 1E6 * z[0] * z[0]
+		for _, v := range z[1:] {
+			ans += v * v
+		}
+		return ans
+	}, xopt
+}
+
+// f12: f(x) = z_1^2 + 10^6 * sum_(i>1) z_i^2, z = R * T_asy^0.5(R * (x - xopt)), xopt is generated with seed + 1000000 as R
+func bentCigar(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt, r := computeXOpt(dim, seed+1000000), rotationR(dim, seed)
+	return func(x []float64) float64 {
+		z := multiply(r, tasy(multiply(r, shift(x, xopt)), 0.5))
+		ans := 0.0
+		for _, v := range z[1:] {
+			ans += v * v
+		}
+		return z[0]*z[0] + 1E6*ans
+	}, xopt
+}
+
+// f13: f(x) = z_1^2 + 100 * sqrt(sum_(i>1) z_i^2), z = R * Λ^10 * Q * (x - xopt)
+func sharpRidge(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt := computeXOpt(dim, seed)
+	m := conditioning(rotationR(dim, seed), 10.0, rotationQ(dim, seed))
+	return func(x []float64) float64 {
+		z := multiply(m, shift(x, xopt))
+		ans := 0.0
+		for _, v := range z[1:] {
+			ans += v * v
+		}
+		return z[0]*z[0] + 100.0*math.Sqrt(ans)
+	}, xopt
+}
+
+// f14: f(x) = sqrt(sum |z_i|^(2+4*i/(D-1))), z = R * (x - xopt)
+func differentPowers(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt, r := computeXOpt(dim, seed), rotationR(dim, seed)
+	return func(x []float64) float64 {
+		ans := 0.0
+		for i, v := range multiply(r, shift(x, xopt)) {
+			ans += math.Pow(math.Abs(v), 2.0+4.0*ratio(i, dim))
+		}
+		return math.Sqrt(ans)
+	}, xopt
+}
+
+//*************************************************************
+// Multi-modal functions with adequate global structure
+//*************************************************************
+
+// f15: f(x) = 10*(D - sum cos(2*pi*z_i)) + ||z||^2, z = R * Λ^10 * Q * T_asy^0.2(T_osz(R * (x - xopt)))
+func rastriginRotated(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt, r := computeXOpt(dim, seed), rotationR(dim, seed)
+	m := conditioning(r, 10.0, rotationQ(dim, seed))
+	return func(x []float64) float64 {
+		return rastrigin(multiply(m, tasy(tosz(multiply(r, shift(x, xopt))), 0.2)))
+	}, xopt
+}
+
+// f16: f(x) = 10 * (1/D * sum_i sum_k 1/2^k * cos(2*pi*3^k*(z_i+1/2)) - f0)^3 + 10/D * fpen(x), k = 0..11,
+// z = R * Λ^(1/100) * Q * T_osz(R * (x - xopt)), f0 = sum_k 1/2^k * cos(pi*3^k)
+func weierstrass(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt, r := computeXOpt(dim, seed), rotationR(dim, seed)
+	m := conditioning(r, 0.01, rotationQ(dim, seed))
+	f0 := 0.0
+	for k := 0; k < 12; k++ {
+		f0 += math.Pow(0.5, float64(k)) * math.Cos(math.Pi*math.Pow(3.0, float64(k)))
+	}
+	return func(x []float64) float64 {
+		ans := 0.0
+		for _, v := range multiply(m, tosz(multiply(r, shift(x, xopt)))) {
+			for k := 0; k < 12; k++ {
+				ans += math.Pow(0.5, float64(k)) * math.Cos(2.0*math.Pi*math.Pow(3.0, float64(k))*(v+0.5))
+			}
+		}
+		ans = ans/float64(dim) - f0
+		return 10.0*ans*ans*ans + 10.0/float64(dim)*fpen(x)
+	}, xopt
+}
+
+// f17: f(x) = (1/(D-1) * sum sqrt(s_i) + sqrt(s_i)*sin^2(50*s_i^0.2))^2 + 10*fpen(x), s_i = sqrt(z_i^2 + z_(i+1)^2),
+// z = Λ^10 * Q * T_asy^0.5(R * (x - xopt))
+func schaffersF7(dim int, seed int64) (func(x []float64) float64, []float64) {
+	return schaffersWithConditioning(dim, seed, 10.0)
+}
+
+// f18: the moderately ill-conditioned f17 with Λ^1000
+func schaffersF7Ill(dim int, seed int64) (func(x []float64) float64, []float64) {
+	return schaffersWithConditioning(dim, seed, 1000.0)
+}
+
+// schaffersWithConditioning returns the Schaffers F7 function with Λ^alpha
+func schaffersWithConditioning(dim int, seed int64, alpha float64) (func(x []float64) float64, []float64) {
+	xopt, r := computeXOpt(dim, seed), rotationR(dim, seed)
+	m := conditioning(nil, alpha, rotationQ(dim, seed))
+	return func(x []float64) float64 {
+		return schaffers(multiply(m, tasy(multiply(r, shift(x, xopt)), 0.5))) + 10.0*fpen(x)
+	}, xopt
+}
+
+// f19: f(x) = 10/(D-1) * sum (s_i/4000 - cos(s_i)) + 10, s_i = 100*(z_i^2 - z_(i+1))^2 + (z_i - 1)^2,
+// z = max(1, sqrt(D)/8) * R * x + 1/2
+func griewankRosenbrock(dim int, seed int64) (func(x []float64) float64, []float64) {
+	r, factor := rotation(dim, seed), math.Max(1.0, math.Sqrt(float64(dim))/8.0)
+	return func(x []float64) float64 {
+		z := rosenbrockZ(r, factor, x)
+		ans := 0.0
+		for i := 0; i < dim-1; i++ {
+			a, b := z[i]*z[i]-z[i+1], z[i]-1.0
+			s := 100.0*a*a + b*b
+			ans += s/4000.0 - math.Cos(s)
+		}
+		return 10.0/float64(dim-1)*ans + 10.0
+	}, rosenbrockXOpt(r, factor)
+}
+
+//*************************************************************
+// Multi-modal functions with weak global structure
+//*************************************************************
+
+// f20: f(x) = -1/(100*D) * sum z_i*sin(sqrt(|z_i|)) + 4.189828872724339 + 100*fpen(z/100),
+// xh = 2 * sign(xopt) * x, zh_1 = xh_1, zh_(i+1) = xh_(i+1) + 0.25*(xh_i - 2*|xopt_i|), z = 100 * (Λ^10 * (zh - 2*|xopt|) + 2*|xopt|)
+func schwefel(dim int, seed int64) (func(x []float64) float64, []float64) {
+	u := unif(dim, seed)
+	xopt := make([]float64, dim)
+	for i, v := range u {
+		xopt[i] = 0.5 * 4.2096874637
+		if v < 0.5 {
+			xopt[i] = -xopt[i]
+		}
+	}
+	return func(x []float64) float64 {
+		xh := make([]float64, dim)
+		for i, v := range x {
+			xh[i] = 2.0 * sign(xopt[i]) * v
+		}
+		zh := make([]float64, dim)
+		zh[0] = xh[0]
+		for i := 1; i < dim; i++ {
+			zh[i] = xh[i] + 0.25*(xh[i-1]-2.0*math.Abs(xopt[i-1]))
+		}
+		for i := range zh {
+			zh[i] -= 2.0 * math.Abs(xopt[i])
+		}
+		z := lambda(zh, 10.0)
+		pen := make([]float64, dim)
+		ans := 0.0
+		for i := range z {
+			z[i] = 100.0 * (z[i] + 2.0*math.Abs(xopt[i]))
+			pen[i] = z[i] / 100.0
+			ans += z[i] * math.Sin(math.Sqrt(math.Abs(z[i])))
+		}
+		return -ans/(100.0*float64(dim)) + 4.189828872724339 + 100.0*fpen(pen)
+	}, xopt
+}
+
+// f21: Gallagher's Gaussian 101-me peaks function
+func gallagher101(dim int, seed int64) (func(x []float64) float64, []float64) {
+	return gallagherPeaks(dim, seed, 101, math.Sqrt(1000.0), 10.0, 5.0)
+}
+
+// f22: Gallagher's Gaussian 21-hi peaks function
+func gallagher21(dim int, seed int64) (func(x []float64) float64, []float64) {
+	return gallagherPeaks(dim, seed, 21, 1000.0, 9.8, 4.9)
+}
+
+// gallagherPeaks returns f(x) = T_osz(10 - max_i w_i*exp(-1/(2D) * (x-y_i)^T * R^T * C_i * R * (x-y_i)))^2 + fpen(x).
+// w_1 = 10 and the others are evenly spaced in [1.1, 9.1], y_1 = xopt is uniform in 0.8*[-c, c] and the others are uniform in [-c, c],
+// C_i is the diagonal matrix with the permuted condition^(j/(D-1)-0.5), condition of the global peak is condition1,
+// and those of the other peaks are 1000^(j/(n-2)) with a random permutation j.
+func gallagherPeaks(dim int, seed int64, n int, condition1, b, c float64) (func(x []float64) float64, []float64) {
+	r := rotation(dim, seed)
+	values, conditions := make([]float64, n), make([]float64, n)
+	values[0], conditions[0] = 10.0, condition1
+	for i, p := range permutation(n-1, seed) {
+		values[i+1] = float64(i)/float64(n-2)*(9.1-1.1) + 1.1
+		conditions[i+1] = math.Pow(1000.0, float64(p)/float64(n-2))
+	}
+	scales := make([][]float64, n)
+	for i := range scales {
+		scales[i] = make([]float64, dim)
+		for j, p := range permutation(dim, seed+1000*int64(i)) {
+			scales[i][j] = math.Pow(conditions[i], ratio(p, dim)-0.5)
+		}
+	}
+	u := unif(dim*n, seed)
+	xopt := make([]float64, dim)
+	for i := range xopt {
+		xopt[i] = 0.8 * (b*u[i] - c)
+	}
+	// the peaks are kept in the rotated space
+	peaks := make([][]float64, n)
+	peaks[0] = multiply(r, xopt)
+	for i := 1; i < n; i++ {
+		y := make([]float64, dim)
+		for k := range y {
+			y[k] = b*u[i*dim+k] - c
+		}
+		peaks[i] = multiply(r, y)
+	}
+	return func(x []float64) float64 {
+		rx := multiply(r, x)
+		best := 0.0
+		for i, y := range peaks {
+			d := 0.0
+			for j, v := range rx {
+				d += scales[i][j] * (v - y[j]) * (v - y[j])
+			}
+			best = math.Max(best, values[i]*math.Exp(-0.5/float64(dim)*d))
+		}
+		o := oscillate(10.0 - best)
+		return o*o + fpen(x)
+	}, xopt
+}
+
+// f23: f(x) = 10/D^2 * prod_i (1 + i * sum_j |2^j*z_i - round(2^j*z_i)|/2^j)^(10/D^1.2) - 10/D^2 + fpen(x), i = 1..D, j = 1..32,
+// z = R * Λ^100 * Q * (x - xopt)
+func katsuura(dim int, seed int64) (func(x []float64) float64, []float64) {
+	xopt := computeXOpt(dim, seed)
+	m := conditioning(rotationR(dim, seed), 100.0, rotationQ(dim, seed))
+	d := float64(dim)
+	return func(x []float64) float64 {
+		ans := 1.0
+		for i, v := range multiply(m, shift(x, xopt)) {
+			s := 0.0
+			for j := 1; j <= 32; j++ {
+				p := math.Pow(2.0, float64(j))
+				s += math.Abs(p*v-round(p*v)) / p
+			}
+			ans *= math.Pow(1.0+float64(i+1)*s, 10.0/math.Pow(d, 1.2))
+		}
+		return 10.0/(d*d)*ans - 10.0/(d*d) + fpen(x)
+	}, xopt
+}
+
+// f24: f(x) = min(sum (xh_i - mu0)^2, D + s * sum (xh_i - mu1)^2) + 10*(D - sum cos(2*pi*z_i)) + 10^4 * fpen(x),
+// xh = 2 * sign(xopt) * x, z = R * Λ^100 * Q * (xh - mu0), mu0 = 2.5, s = 1 - 1/(2*sqrt(D+20) - 8.2), mu1 = -sqrt((mu0^2 - 1)/s)
+func lunacekBiRastrigin(dim int, seed int64) (func(x []float64) float64, []float64) {
+	d := float64(dim)
+	mu0 := 2.5
+	s := 1.0 - 1.0/(2.0*math.Sqrt(d+20.0)-8.2)
+	mu1 := -math.Sqrt((mu0*mu0 - 1.0) / s)
+	xopt := gauss(dim, seed)
+	for i, v := range xopt {
+		xopt[i] = 0.5 * mu0 * sign(v)
+	}
+	m := conditioning(rotationR(dim, seed), 100.0, rotationQ(dim, seed))
+	return func(x []float64) float64 {
+		xh := make([]float64, dim)
+		s0, s1 := 0.0, 0.0
+		for i, v := range x {
+			xh[i] = 2.0*sign(xopt[i])*v - mu0
+			s0 += xh[i] * xh[i]
+			s1 += (xh[i] + mu0 - mu1) * (xh[i] + mu0 - mu1)
+		}
+		c := 0.0
+		for _, v := range multiply(m, xh) {
+			c += math.Cos(2.0 * math.Pi * v)
+		}
+		return math.Min(s0, d+s*s1) + 10.0*(d-c) + 1E4*fpen(x)
+	}, xopt
+}
@@ -0,0 +1,269 @@
+package bbob
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sineatos/deag/base"
+)
+
+// FinalTarget is the final target precision of the BBOB experiments, a run reaching it is regarded as successful
+const FinalTarget float64 = 1E-8
+
+// DefaultTargets returns the 51 target precisions 10^2, 10^1.8, ..., 10^-8 used by COCO
+func DefaultTargets() []float64 {
+	targets := make([]float64, 51)
+	for k := range targets {
+		targets[k] = math.Pow(10.0, 2.0-0.2*float64(k))
+	}
+	return targets
+}
+
+// Run keeps the evaluations of one run of an algorithm on a Problem, including the best value and the evaluations to reach each target precision.
+// The precision of a value f is f - fopt.
+type Run struct {
+	problem   *Problem
+	targets   []float64
+	hits      []int
+	evals     int
+	best      float64
+	observer  *Observer
+	datLevel  float64   // log level of the last record in the .dat file
+	lastDat   int       // evaluations of the last record in the .dat file
+	lastTdat  int       // evaluations of the last record in the .tdat file
+	lastX     []float64 // the last evaluated solution recorded by the observer
+	lastValue float64   // the last evaluated value recorded by the observer
+}
+
+// NewRun returns *Run of p with the target precisions, which are sorted in descending order. DefaultTargets() is used if targets is nil.
+func NewRun(p *Problem, targets []float64) *Run {
+	if targets == nil {
+		targets = DefaultTargets()
+	}
+	sorted := make([]float64, len(targets))
+	copy(sorted, targets)
+	sort.Sort(sort.Reverse(sort.Float64Slice(sorted)))
+	hits := make([]int, len(sorted))
+	for i := range hits {
+		hits[i] = -1
+	}
+	return &Run{problem: p, targets: sorted, hits: hits, best: math.Inf(1), datLevel: math.Inf(1)}
+}
+
+// Evaluate is the benchmarks.Float64Evaluator of the run, it evaluates individual by the Problem and records the evaluation
+func (r *Run) Evaluate(individual *base.Float64Individual) []float64 {
+	x := individual.GetChromosome().([]float64)
+	value := r.problem.Value(x)
+	r.evals++
+	improved := value < r.best
+	if improved {
+		r.best = value
+		delta := r.GetBestDelta()
+		for i, t := range r.targets {
+			if r.hits[i] < 0 && delta <= t {
+				r.hits[i] = r.evals
+			}
+		}
+	}
+	if r.observer != nil {
+		// x is copied since the individual may be modified before the run is finished
+		r.lastX, r.lastValue = append(r.lastX[:0], x...), value
+		r.observer.record(r, improved)
+	}
+	return []float64{value}
+}
+
+// GetProblem returns the Problem of the run
+func (r *Run) GetProblem() *Problem {
+	return r.problem
+}
+
+// GetEvaluations returns the number of evaluations
+func (r *Run) GetEvaluations() int {
+	return r.evals
+}
+
+// GetBest returns the best value, it is +Inf before the first evaluation
+func (r *Run) GetBest() float64 {
+	return r.best
+}
+
+// GetBestDelta returns the precision of the best value, it is +Inf before the first evaluation
+func (r *Run) GetBestDelta() float64 {
+	return r.best - r.problem.GetFOpt()
+}
+
+// GetTargets returns the target precisions in descending order
+func (r *Run) GetTargets() []float64 {
+	ans := make([]float64, len(r.targets))
+	copy(ans, r.targets)
+	return ans
+}
+
+// EvaluationsToTargets returns the number of evaluations to reach each target of GetTargets(), -1 means the target isn't reached
+func (r *Run) EvaluationsToTargets() []int {
+	ans := make([]int, len(r.hits))
+	copy(ans, r.hits)
+	return ans
+}
+
+// EvaluationsToTarget returns the number of evaluations to reach the precision target, -1 means the target isn't reached
+func (r *Run) EvaluationsToTarget(target float64) int {
+	for i, t := range r.targets {
+		if t == target {
+			return r.hits[i]
+		}
+	}
+	panic(fmt.Sprintf("The target isn't in the targets of the run: %v", target))
+}
+
+// IsSolved returns if the best value reaches FinalTarget
+func (r *Run) IsSolved() bool {
+	return r.GetBestDelta() <= FinalTarget
+}
+
+// Observer writes the data files of the runs in the format of the "bbob" logger of COCO, which can be post-processed by cocopp.
+// For the function f and the dimensions D, the files are:
+//
+// root/bbobexp_f{f}.info: the information of the runs, each instance is recorded as "instance:evaluations|best precision".
+//
+// root/data_f{f}/bbobexp_f{f}_DIM{D}.dat: the records triggered by the improvements of the best precision, one record per 0.2 in log10 scale.
+//
+// root/data_f{f}/bbobexp_f{f}_DIM{D}.tdat: the records triggered by the number of evaluations, which are 1, 2 and 5 times powers of 10.
+type Observer struct {
+	root    string
+	algID   string
+	comment string
+	run     *Run
+	key     string
+	err     error
+}
+
+// NewObserver returns *Observer writing the data files into the directory root, algID is the name of the algorithm and comment describes the experiment
+func NewObserver(root, algID, comment string) *Observer {
+	return &Observer{root: root, algID: algID, comment: comment}
+}
+
+// Observe finishes the current run and returns the new *Run of p with DefaultTargets(), whose Evaluate records the data files
+func (o *Observer) Observe(p *Problem) *Run {
+	o.finishRun()
+	run := NewRun(p, nil)
+	run.observer = o
+	o.run = run
+	f, dim := p.GetFunction(), p.Len()
+	dat := fmt.Sprintf("data_f%d/bbobexp_f%d_DIM%d.dat", f, f, dim)
+	if key := fmt.Sprintf("f%d_DIM%d", f, dim); key != o.key {
+		o.key = key
+		info := o.infoPath(f)
+		header := fmt.Sprintf("funcId = %d, DIM = %d, Precision = %.3e, algId = '%s'\n%% %s\n%s", f, dim, FinalTarget, o.algID, o.comment, dat)
+		if stat, err := os.Stat(info); err == nil && stat.Size() > 0 {
+			header = "\n" + header
+		}
+		o.write(info, header)
+	}
+	columns := []string{"function evaluation", fmt.Sprintf("noise-free fitness - Fopt (%13.12e)", p.GetFOpt()), "best noise-free fitness - Fopt", "measured fitness", "best measured fitness"}
+	for i := 1; i <= dim; i++ {
+		columns = append(columns, fmt.Sprintf("x%d", i))
+	}
+	header := "% " + strings.Join(columns, " | ") + "\n"
+	o.write(o.dataPath(run, ".dat"), header)
+	o.write(o.dataPath(run, ".tdat"), header)
+	return run
+}
+
+// Finish finishes the current run, it returns the first error of writing the data files
+func (o *Observer) Finish() error {
+	o.finishRun()
+	return o.err
+}
+
+// finishRun writes the last evaluation of the current run and appends the run to the .info file
+func (o *Observer) finishRun() {
+	r := o.run
+	if r == nil {
+		return
+	}
+	o.run = nil
+	if r.evals > 0 {
+		if r.lastDat != r.evals {
+			o.write(o.dataPath(r, ".dat"), o.line(r))
+		}
+		if r.lastTdat != r.evals {
+			o.write(o.dataPath(r, ".tdat"), o.line(r))
+		}
+	}
+	o.write(o.infoPath(r.problem.GetFunction()), fmt.Sprintf(", %d:%d|%.1e", r.problem.GetInstance(), r.evals, r.GetBestDelta()))
+}
+
+// record writes the records of the last evaluation of r
+func (o *Observer) record(r *Run, improved bool) {
+	if improved {
+		if level := math.Floor(5.0 * math.Log10(r.GetBestDelta())); level < r.datLevel {
+			r.datLevel = level
+			r.lastDat = r.evals
+			o.write(o.dataPath(r, ".dat"), o.line(r))
+		}
+	}
+	if isTdatTrigger(r.evals) {
+		r.lastTdat = r.evals
+		o.write(o.dataPath(r, ".tdat"), o.line(r))
+	}
+}
+
+// line returns the record of the last evaluation of r
+func (o *Observer) line(r *Run) string {
+	fopt := r.problem.GetFOpt()
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d %+10.9e %+10.9e %+10.9e %+10.9e", r.evals, r.lastValue-fopt, r.best-fopt, r.lastValue, r.best)
+	for _, v := range r.lastX {
+		fmt.Fprintf(&b, " %+5.4e", v)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+func (o *Observer) infoPath(function int) string {
+	return filepath.Join(o.root, fmt.Sprintf("bbobexp_f%d.info", function))
+}
+
+func (o *Observer) dataPath(r *Run, ext string) string {
+	f := r.problem.GetFunction()
+	return filepath.Join(o.root, fmt.Sprintf("data_f%d", f), fmt.Sprintf("bbobexp_f%d_DIM%d%s", f, r.problem.Len(), ext))
+}
+
+// write appends text to the file of path, the first error is kept
+func (o *Observer) write(path, text string) {
+	if o.err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		o.err = err
+		return
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		o.err = err
+		return
+	}
+	if _, err = file.WriteString(text); err != nil {
+		o.err = err
+	}
+	if err = file.Close(); err != nil && o.err == nil {
+		o.err = err
+	}
+}
+
+// isTdatTrigger returns if evals is 1, 2 or 5 times a power of 10
+func isTdatTrigger(evals int) bool {
+	if evals < 1 {
+		return false
+	}
+	for evals%10 == 0 {
+		evals /= 10
+	}
+	return evals == 1 || evals == 2 || evals == 5
+}
@@ -0,0 +1,92 @@
+package bbob
+
+import (
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+)
+
+// randomSearch evaluates the solutions sampled around xopt with the decreasing radius by evaluate
+func randomSearch(p *Problem, evaluate func(*base.Float64Individual) []float64, evals int) {
+	xopt := p.GetXOpt()
+	for k := 0; k < evals; k++ {
+		radius := math.Pow(10.0, -12.0*float64(k)/float64(evals))
+		x := make([]float64, p.Len())
+		for i := range x {
+			x[i] = xopt[i] + radius*(2.0*rand.Float64()-1.0)
+		}
+		evaluate(base.NewFloat64Individual(x, base.NewFitness([]float64{-1.0})))
+	}
+}
+
+func TestRun(t *testing.T) {
+	p := NewProblem(1, 1, 5)
+	r := NewRun(p, []float64{1E-8, 1.0, 1E-2})
+	if targets := r.GetTargets(); targets[0] != 1.0 || targets[2] != 1E-8 {
+		t.Errorf("the targets should be sorted in descending order: %v", targets)
+	}
+	if r.EvaluationsToTarget(1.0) != -1 || r.IsSolved() {
+		t.Error("no target should be reached before the evaluations")
+	}
+	randomSearch(p, r.Evaluate, 1000)
+	if r.GetEvaluations() != 1000 || !r.IsSolved() {
+		t.Errorf("the run should be solved in 1000 evaluations: %v, %v", r.GetEvaluations(), r.GetBestDelta())
+	}
+	hits := r.EvaluationsToTargets()
+	if hits[0] < 1 || hits[0] > hits[1] || hits[1] > hits[2] || hits[2] > 1000 {
+		t.Errorf("the targets should be reached in order: %v", hits)
+	}
+	if r.EvaluationsToTarget(1E-8) != hits[2] {
+		t.Errorf("wrong evaluations to the final target: %v", r.EvaluationsToTarget(1E-8))
+	}
+}
+
+func TestObserver(t *testing.T) {
+	root := t.TempDir()
+	o := NewObserver(root, "random", "random search around xopt")
+	for instance := 1; instance <= 2; instance++ {
+		p := NewProblem(3, instance, 2)
+		r := o.Observe(p)
+		randomSearch(p, r.Evaluate, 123)
+	}
+	if err := o.Finish(); err != nil {
+		t.Fatal(err)
+	}
+	info, err := ioutil.ReadFile(filepath.Join(root, "bbobexp_f3.info"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(string(info), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "funcId = 3, DIM = 2, Precision = 1.000e-08, algId = 'random'") {
+		t.Errorf("wrong info file: %v", string(info))
+	}
+	if !strings.HasPrefix(lines[2], "data_f3/bbobexp_f3_DIM2.dat, 1:123|") || !strings.Contains(lines[2], ", 2:123|") {
+		t.Errorf("wrong runs in the info file: %v", lines[2])
+	}
+	for _, ext := range []string{".dat", ".tdat"} {
+		data, err := ioutil.ReadFile(filepath.Join(root, "data_f3", "bbobexp_f3_DIM2"+ext))
+		if err != nil {
+			t.Fatal(err)
+		}
+		headers, last := 0, ""
+		for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+			if strings.HasPrefix(line, "%") {
+				headers++
+			} else {
+				last = line
+			}
+		}
+		// the last evaluation of each run is recorded
+		if fields := strings.Fields(last); headers != 2 || len(fields) != 7 || fields[0] != "123" {
+			t.Errorf("wrong %v file: %v", ext, string(data))
+		}
+	}
+	if !isTdatTrigger(1) || !isTdatTrigger(20) || !isTdatTrigger(500) || isTdatTrigger(30) || isTdatTrigger(0) {
+		t.Error("wrong triggers of the .tdat file")
+	}
+}
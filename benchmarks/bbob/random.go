@@ -0,0 +1,135 @@
+package bbob
+
+import (
+	"math"
+)
+
+// unif returns n uniform numbers in (0, 1) generated by the pseudo random generator of BBOB 2009 with seed,
+// which is a Park-Miller generator shuffled by a table of 32 numbers. The same seed always gives the same numbers.
+func unif(n int, seed int64) []float64 {
+	if seed < 0 {
+		seed = -seed
+	}
+	if seed < 1 {
+		seed = 1
+	}
+	next := func(s int64) int64 {
+		tmp := s / 127773
+		s = 16807*(s-tmp*127773) - 2836*tmp
+		if s < 0 {
+			s += 2147483647
+		}
+		return s
+	}
+	table := make([]int64, 32)
+	for i := 39; i >= 0; i-- {
+		seed = next(seed)
+		if i < 32 {
+			table[i] = seed
+		}
+	}
+	current := table[0]
+	ans := make([]float64, n)
+	for i := range ans {
+		seed = next(seed)
+		k := current / 67108865
+		current = table[k]
+		table[k] = seed
+		ans[i] = float64(current) / 2.147483647e9
+		if ans[i] == 0.0 {
+			ans[i] = 1E-99
+		}
+	}
+	return ans
+}
+
+// gauss returns n standard normal numbers transformed from 2n numbers of unif by the Box-Muller method
+func gauss(n int, seed int64) []float64 {
+	u := unif(2*n, seed)
+	ans := make([]float64, n)
+	for i := range ans {
+		ans[i] = math.Sqrt(-2.0*math.Log(u[i])) * math.Cos(2.0*math.Pi*u[n+i])
+		if ans[i] == 0.0 {
+			ans[i] = 1E-99
+		}
+	}
+	return ans
+}
+
+// rotation returns the dim*dim random orthogonal matrix of seed, the columns of a matrix of gauss are orthonormalized by the Gram-Schmidt process
+func rotation(dim int, seed int64) [][]float64 {
+	g := gauss(dim*dim, seed)
+	b := make([][]float64, dim)
+	for i := range b {
+		b[i] = make([]float64, dim)
+		for j := range b[i] {
+			b[i][j] = g[j*dim+i]
+		}
+	}
+	for i := 0; i < dim; i++ {
+		for j := 0; j < i; j++ {
+			prod := 0.0
+			for k := 0; k < dim; k++ {
+				prod += b[k][i] * b[k][j]
+			}
+			for k := 0; k < dim; k++ {
+				b[k][i] -= prod * b[k][j]
+			}
+		}
+		prod := 0.0
+		for k := 0; k < dim; k++ {
+			prod += b[k][i] * b[k][i]
+		}
+		for k := 0; k < dim; k++ {
+			b[k][i] /= math.Sqrt(prod)
+		}
+	}
+	return b
+}
+
+// computeXOpt returns the optimal solution of seed, which is uniform in [-4, 4] with the precision 1E-4 and not 0 in any dimension
+func computeXOpt(dim int, seed int64) []float64 {
+	xopt := unif(dim, seed)
+	for i, v := range xopt {
+		xopt[i] = 8.0*math.Floor(1E4*v)/1E4 - 4.0
+		if xopt[i] == 0.0 {
+			xopt[i] = -1E-5
+		}
+	}
+	return xopt
+}
+
+// instanceSeed returns the seed of the instance of the function, f4 and f18 share the seeds with f3 and f17
+func instanceSeed(function, instance int) int64 {
+	switch function {
+	case 4:
+		function = 3
+	case 18:
+		function = 17
+	}
+	return int64(function) + 10000*int64(instance)
+}
+
+// computeFOpt returns the optimal value of the instance of the function, which is a Cauchy distributed number rounded to 2 decimals in [-1000, 1000]
+func computeFOpt(function, instance int) float64 {
+	seed := instanceSeed(function, instance)
+	g1, g2 := gauss(1, seed)[0], gauss(1, seed+1)[0]
+	fopt := math.Floor(100.0*100.0*g1/g2+0.5) / 100.0
+	return math.Min(1000.0, math.Max(-1000.0, fopt))
+}
+
+// permutation returns the indices which sort n numbers of unif with seed in ascending order, which is a random permutation of [0, n)
+func permutation(n int, seed int64) []int {
+	u := unif(n, seed)
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	// insertion sort keeps it stable and n is small
+	for i := 1; i < n; i++ {
+		for j := i; j > 0 && u[perm[j]] < u[perm[j-1]]; j-- {
+			perm[j], perm[j-1] = perm[j-1], perm[j]
+		}
+	}
+	return perm
+}
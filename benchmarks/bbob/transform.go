@@ -0,0 +1,187 @@
+package bbob
+
+import (
+	"math"
+)
+
+// ratio returns i/(dim-1), it returns 0 when dim is 1
+func ratio(i, dim int) float64 {
+	if dim < 2 {
+		return 0.0
+	}
+	return float64(i) / float64(dim-1)
+}
+
+// shift returns x - xopt
+func shift(x, xopt []float64) []float64 {
+	ans := make([]float64, len(x))
+	for i, v := range x {
+		ans[i] = v - xopt[i]
+	}
+	return ans
+}
+
+// multiply returns m * x
+func multiply(m [][]float64, x []float64) []float64 {
+	ans := make([]float64, len(m))
+	for i, row := range m {
+		for j, v := range x {
+			ans[i] += row[j] * v
+		}
+	}
+	return ans
+}
+
+// transpose returns the transpose of m
+func transpose(m [][]float64) [][]float64 {
+	ans := make([][]float64, len(m))
+	for i := range ans {
+		ans[i] = make([]float64, len(m))
+		for j := range ans[i] {
+			ans[i][j] = m[j][i]
+		}
+	}
+	return ans
+}
+
+// conditioning returns the matrix q * Λ^alpha * r, r and q are rotations and Λ^alpha is the diagonal matrix with alpha^(0.5*i/(D-1)).
+// q or r can be nil as the identity.
+func conditioning(q [][]float64, alpha float64, r [][]float64) [][]float64 {
+	dim := len(q)
+	if r != nil {
+		dim = len(r)
+	}
+	get := func(m [][]float64, i, j int) float64 {
+		if m != nil {
+			return m[i][j]
+		}
+		if i == j {
+			return 1.0
+		}
+		return 0.0
+	}
+	ans := make([][]float64, dim)
+	for i := range ans {
+		ans[i] = make([]float64, dim)
+		for j := range ans[i] {
+			for k := 0; k < dim; k++ {
+				ans[i][j] += get(q, i, k) * math.Pow(alpha, 0.5*ratio(k, dim)) * get(r, k, j)
+			}
+		}
+	}
+	return ans
+}
+
+// lambda returns Λ^alpha * x
+func lambda(x []float64, alpha float64) []float64 {
+	ans := make([]float64, len(x))
+	for i, v := range x {
+		ans[i] = v * math.Pow(alpha, 0.5*ratio(i, len(x)))
+	}
+	return ans
+}
+
+// oscillate is the oscillation T_osz of a value, which introduces small and smooth regular irregularities
+func oscillate(v float64) float64 {
+	if v == 0.0 {
+		return 0.0
+	}
+	c1, c2 := 5.5, 3.1
+	if v > 0.0 {
+		c1, c2 = 10.0, 7.9
+	}
+	h := math.Log(math.Abs(v))
+	return sign(v) * math.Exp(h+0.049*(math.Sin(c1*h)+math.Sin(c2*h)))
+}
+
+// tosz returns T_osz(x) applied to each component
+func tosz(x []float64) []float64 {
+	ans := make([]float64, len(x))
+	for i, v := range x {
+		ans[i] = oscillate(v)
+	}
+	return ans
+}
+
+// tasy returns T_asy^beta(x), the positive components x_i are changed to x_i^(1+beta*i/(D-1)*sqrt(x_i))
+func tasy(x []float64, beta float64) []float64 {
+	ans := make([]float64, len(x))
+	for i, v := range x {
+		ans[i] = v
+		if v > 0.0 {
+			ans[i] = math.Pow(v, 1.0+beta*ratio(i, len(x))*math.Sqrt(v))
+		}
+	}
+	return ans
+}
+
+// fpen is the boundary penalty, the sum of the squared distances out of [-5, 5]
+func fpen(x []float64) float64 {
+	ans := 0.0
+	for _, v := range x {
+		if d := math.Abs(v) - 5.0; d > 0.0 {
+			ans += d * d
+		}
+	}
+	return ans
+}
+
+// sign returns the sign of v, and 0 when v is 0
+func sign(v float64) float64 {
+	switch {
+	case v > 0.0:
+		return 1.0
+	case v < 0.0:
+		return -1.0
+	}
+	return 0.0
+}
+
+// round rounds v to the nearest integer as floor(v + 0.5)
+func round(v float64) float64 {
+	return math.Floor(v + 0.5)
+}
+
+// rastrigin returns 10*(D - sum cos(2*pi*z_i)) + ||z||^2
+func rastrigin(z []float64) float64 {
+	c, s := 0.0, 0.0
+	for _, v := range z {
+		c += math.Cos(2.0 * math.Pi * v)
+		s += v * v
+	}
+	return 10.0*(float64(len(z))-c) + s
+}
+
+// ellipsoid returns sum 10^(6*i/(D-1)) * z_i^2
+func ellipsoid(z []float64) float64 {
+	ans := 0.0
+	for i, v := range z {
+		ans += math.Pow(10.0, 6.0*ratio(i, len(z))) * v * v
+	}
+	return ans
+}
+
+// rosenbrock returns sum 100*(z_i^2 - z_(i+1))^2 + (z_i - 1)^2
+func rosenbrock(z []float64) float64 {
+	ans := 0.0
+	for i := 0; i < len(z)-1; i++ {
+		a, b := z[i]*z[i]-z[i+1], z[i]-1.0
+		ans += 100.0*a*a + b*b
+	}
+	return ans
+}
+
+// schaffers returns the Schaffers F7 function of z
+func schaffers(z []float64) float64 {
+	if len(z) < 2 {
+		return 0.0
+	}
+	ans := 0.0
+	for i := 0; i < len(z)-1; i++ {
+		s := math.Sqrt(z[i]*z[i] + z[i+1]*z[i+1])
+		t := math.Sin(50.0 * math.Pow(s, 0.2))
+		ans += math.Sqrt(s) + math.Sqrt(s)*t*t
+	}
+	ans /= float64(len(z) - 1)
+	return ans * ans
+}
@@ -1,11 +1,15 @@
 package benchmarks
 
 import (
+	"fmt"
 	"math"
 
 	"github.com/sineatos/deag/base"
 )
 
+// BoolEvaluator is the type of bool evaluator
+type BoolEvaluator func(individual *base.BoolIndividual) []float64
+
 // Trap is binary benchmark
 func Trap(individual *base.BoolIndividual) []float64 {
 	u, k := 0, individual.Len()
@@ -123,17 +127,18 @@ func ChuangF3(individual *base.BoolIndividual) []float64 {
 //
 // Royal Road Function R1 as presented by Melanie Mitchell in : "An introduction to Genetic Algorithms".
 func RoyalRoad1(individual *base.BoolIndividual, order int) []float64 {
-	total, nelem := 0.0, individual.Len()
+	total, nelem := 0.0, individual.Len()/order
 	maxValue := math.Pow(2.0, float64(order)) - 1
 	for i := 0; i < nelem; i++ {
 		value := 0.0
 		chrom := individual.GetChromosome().([]bool)[i*order : i*order+order]
 		for _, val := range chrom {
+			value *= 2.0
 			if val {
-				value *= 2.0
+				value++
 			}
 		}
-		total += float64(order) + value/maxValue
+		total += float64(order) * math.Floor(value/maxValue)
 	}
 	return []float64{total}
 }
@@ -149,3 +154,34 @@ func RoyalRoad2(individual *base.BoolIndividual, order int) []float64 {
 	}
 	return []float64{total}
 }
+
+// HIFF is maximization binary benchmark
+//
+// Hierarchical if-and-only-if function from : R. A. Watson, G. S. Hornby and J. B. Pollack. Modeling Building-Block Interdependency. PPSN V, p. 97 - 106, 1998.
+//
+// The length of the individual must be a power of 2. A block of length 1 scores 1, a larger block scores its length if all its bits are equal plus the scores of its two halves.
+// The function has two global optima in [1,1,...,1] and [0,0,...,0] with the value n(log2(n)+1).
+func HIFF(individual *base.BoolIndividual) []float64 {
+	chrom := individual.GetChromosome().([]bool)
+	n := len(chrom)
+	if n == 0 || n&(n-1) != 0 {
+		panic(fmt.Sprintf("The length of the individual should be a power of 2: %v", n))
+	}
+	total := 0.0
+	// same[i] is if the bits of the i-th block of the current level are equal
+	same := make([]bool, n)
+	for i := range same {
+		same[i] = true
+	}
+	total += float64(n)
+	for size := 2; size <= n; size *= 2 {
+		for b := 0; b < n/size; b++ {
+			left, right := 2*b, 2*b+1
+			same[b] = same[left] && same[right] && chrom[left*size/2] == chrom[right*size/2]
+			if same[b] {
+				total += float64(size)
+			}
+		}
+	}
+	return []float64{total}
+}
@@ -1,6 +1,7 @@
 package benchmarks
 
 import (
+	"math"
 	"math/rand"
 	"testing"
 
@@ -104,6 +105,14 @@ func TestRoyalRoad1(t *testing.T) {
 	ind2.GetFitness().SetValues(ans2)
 	t.Log(ind1)
 	t.Log(ind2)
+	bInd1 := base.NewBoolIndividual(b1, generateFitness())
+	bInd2 := base.NewBoolIndividual(b2, generateFitness())
+	if ans := RoyalRoad1(bInd1, 8)[0]; ans != float64(bdims) {
+		t.Errorf("RoyalRoad1 of all ones should be %v: %v", bdims, ans)
+	}
+	if ans := RoyalRoad1(bInd2, 8)[0]; ans != 0.0 {
+		t.Errorf("RoyalRoad1 of all zeros should be 0: %v", ans)
+	}
 }
 
 func TestRoyalRoad2(t *testing.T) {
@@ -120,3 +129,32 @@ func TestRoyalRoad2(t *testing.T) {
 	t.Log(ind1)
 	t.Log(ind2)
 }
+
+func TestHIFF(t *testing.T) {
+	for _, n := range []int{1, 2, 8, 32} {
+		ones, zeros := make([]bool, n), make([]bool, n)
+		for i := range ones {
+			ones[i] = true
+		}
+		optimum := float64(n) * (math.Log2(float64(n)) + 1.0)
+		for _, chrom := range [][]bool{ones, zeros} {
+			if v := HIFF(base.NewBoolIndividual(chrom, generateFitness()))[0]; v != optimum {
+				t.Errorf("Expected the optimum %v in n=%v, got %v", optimum, n, v)
+			}
+		}
+	}
+	// 0011: 4 singletons, 2 equal pairs and an unequal block
+	if v := HIFF(base.NewBoolIndividual([]bool{false, false, true, true}, generateFitness()))[0]; v != 8.0 {
+		t.Errorf("Expected 8, got %v", v)
+	}
+	// 0101: 4 singletons only
+	if v := HIFF(base.NewBoolIndividual([]bool{false, true, false, true}, generateFitness()))[0]; v != 4.0 {
+		t.Errorf("Expected 4, got %v", v)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("the length which isn't a power of 2 should panic")
+		}
+	}()
+	HIFF(base.NewBoolIndividual(make([]bool, 6), generateFitness()))
+}
@@ -0,0 +1,152 @@
+package btools
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+)
+
+// Translate decorates evaluator to evaluate the individual translated by vector, the decorated function is f(x - vector),
+// so the optimum of evaluator moves from x* to x* + vector. The individual itself isn't modified.
+func Translate(vector []float64, evaluator benchmarks.Float64Evaluator) benchmarks.Float64Evaluator {
+	return func(individual *base.Float64Individual) []float64 {
+		chrom := individual.GetChromosome().([]float64)
+		checkLen("vector", len(vector), len(chrom))
+		x := make([]float64, len(chrom))
+		for i, v := range chrom {
+			x[i] = v - vector[i]
+		}
+		return evaluator(withChromosome(individual, x))
+	}
+}
+
+// Rotate decorates evaluator to evaluate the individual rotated by matrix, the decorated function is f(matrix * x).
+// matrix should be an orthogonal matrix, such as the one returned by RandomOrthogonalMatrix,
+// then the optimum x* at the origin isn't moved. The individual itself isn't modified.
+func Rotate(matrix [][]float64, evaluator benchmarks.Float64Evaluator) benchmarks.Float64Evaluator {
+	return func(individual *base.Float64Individual) []float64 {
+		chrom := individual.GetChromosome().([]float64)
+		checkLen("matrix", len(matrix), len(chrom))
+		x := make([]float64, len(chrom))
+		for i, row := range matrix {
+			checkLen("matrix row", len(row), len(chrom))
+			for j, v := range chrom {
+				x[i] += row[j] * v
+			}
+		}
+		return evaluator(withChromosome(individual, x))
+	}
+}
+
+// Scale decorates evaluator to evaluate the individual scaled by factor, the decorated function is f(x / factor),
+// so the landscape of evaluator is stretched by factor in each dimension. The individual itself isn't modified.
+func Scale(factor []float64, evaluator benchmarks.Float64Evaluator) benchmarks.Float64Evaluator {
+	return func(individual *base.Float64Individual) []float64 {
+		chrom := individual.GetChromosome().([]float64)
+		checkLen("factor", len(factor), len(chrom))
+		x := make([]float64, len(chrom))
+		for i, v := range chrom {
+			x[i] = v / factor[i]
+		}
+		return evaluator(withChromosome(individual, x))
+	}
+}
+
+// Noise decorates evaluator to add the noise to the fitness values, noises[i] returns the noise of the i-th objective and nil means no noise.
+// If there is only one noise, it is used for all the objectives.
+func Noise(noises []func() float64, evaluator benchmarks.Float64Evaluator) benchmarks.Float64Evaluator {
+	return func(individual *base.Float64Individual) []float64 {
+		values := evaluator(individual)
+		ans := make([]float64, len(values))
+		for i, v := range values {
+			noise := noises[0]
+			if len(noises) > 1 {
+				checkLen("noises", len(noises), len(values))
+				noise = noises[i]
+			}
+			ans[i] = v
+			if noise != nil {
+				ans[i] += noise()
+			}
+		}
+		return ans
+	}
+}
+
+// GaussianNoise returns the noise which is normally distributed with mean 0 and standard deviation sigma
+func GaussianNoise(sigma float64) func() float64 {
+	return func() float64 {
+		return rand.NormFloat64() * sigma
+	}
+}
+
+// Bound decorates evaluator to evaluate the individual repaired by b, the components out of b are repaired by the handlers of b
+// with the violated bounds as the parent components, such as clipped by bounds.Clip or mirrored by bounds.Reflect.
+// The individual itself isn't modified.
+func Bound(b *bounds.Bounds, evaluator benchmarks.Float64Evaluator) benchmarks.Float64Evaluator {
+	return func(individual *base.Float64Individual) []float64 {
+		chrom := individual.GetChromosome().([]float64)
+		checkLen("bounds", b.Len(), len(chrom))
+		x := make([]float64, len(chrom))
+		copy(x, chrom)
+		return evaluator(withChromosome(individual, b.Repair(x, nil)))
+	}
+}
+
+// RandomOrthogonalMatrix returns a n*n random orthogonal matrix, which is uniformly distributed over the orthogonal group [Mezzadri2007].
+// It is generated by the Gram-Schmidt orthogonalization of a matrix with the standard normal entries.
+//
+// [Mezzadri2007] Mezzadri, "How to generate random matrices from the classical compact groups", 2007.
+func RandomOrthogonalMatrix(n int) [][]float64 {
+	if n < 1 {
+		panic(fmt.Sprintf("The size of the matrix should be positive: %d", n))
+	}
+	matrix := make([][]float64, n)
+	for i := range matrix {
+		for {
+			row := make([]float64, n)
+			for j := range row {
+				row[j] = rand.NormFloat64()
+			}
+			for _, q := range matrix[:i] {
+				d := dot(row, q)
+				for j := range row {
+					row[j] -= d * q[j]
+				}
+			}
+			// a row nearly dependent on the previous rows is regenerated
+			if norm := math.Sqrt(dot(row, row)); norm > 1E-8 {
+				for j := range row {
+					row[j] /= norm
+				}
+				matrix[i] = row
+				break
+			}
+		}
+	}
+	return matrix
+}
+
+// withChromosome returns a *base.Float64Individual with the chromosome x and the fitness of individual
+func withChromosome(individual *base.Float64Individual, x []float64) *base.Float64Individual {
+	return base.NewFloat64Individual(x, individual.GetFitness())
+}
+
+// checkLen panics if the length of the parameter is different from the dimensions of the individual
+func checkLen(name string, length, dims int) {
+	if length != dims {
+		panic(fmt.Sprintf("The length of %s should be the dimensions of the individual: %d != %d", name, length, dims))
+	}
+}
+
+func dot(x, y []float64) float64 {
+	ans := 0.0
+	for i, v := range x {
+		ans += v * y[i]
+	}
+	return ans
+}
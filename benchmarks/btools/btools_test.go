@@ -0,0 +1,104 @@
+package btools
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/bounds"
+)
+
+const eps float64 = 1E-10
+
+func newIndividual(x ...float64) *base.Float64Individual {
+	return base.NewFloat64Individual(x, base.NewFitness([]float64{-1.0}))
+}
+
+func TestTranslate(t *testing.T) {
+	vector := []float64{1.0, -2.0, 3.0}
+	evaluator := Translate(vector, benchmarks.Sphere)
+	ind := newIndividual(1.0, -2.0, 3.0)
+	if v := evaluator(ind)[0]; math.Abs(v) > eps {
+		t.Errorf("the optimum should be moved to the vector: %v", v)
+	}
+	if x := ind.GetChromosome().([]float64); x[0] != 1.0 {
+		t.Errorf("the individual shouldn't be modified: %v", x)
+	}
+	if v := evaluator(newIndividual(0.0, 0.0, 0.0))[0]; math.Abs(v-14.0) > eps {
+		t.Errorf("wrong value: %v", v)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	for n := 1; n < 10; n++ {
+		matrix := RandomOrthogonalMatrix(n)
+		for i := range matrix {
+			for j := range matrix {
+				target := 0.0
+				if i == j {
+					target = 1.0
+				}
+				if d := dot(matrix[i], matrix[j]); math.Abs(d-target) > eps {
+					t.Errorf("the matrix isn't orthogonal: %v", matrix)
+				}
+			}
+		}
+	}
+	matrix := [][]float64{{0.0, -1.0}, {1.0, 0.0}}
+	// rotating [1, 0] gives [0, 1], Plane returns the first component
+	if v := Rotate(matrix, benchmarks.Plane)(newIndividual(1.0, 0.0))[0]; math.Abs(v) > eps {
+		t.Errorf("wrong value: %v", v)
+	}
+	if v := Rotate(RandomOrthogonalMatrix(5), benchmarks.Sphere)(newIndividual(1.0, 2.0, 3.0, 4.0, 5.0))[0]; math.Abs(v-55.0) > eps {
+		t.Errorf("the rotation should keep the norm: %v", v)
+	}
+}
+
+func TestScale(t *testing.T) {
+	evaluator := Scale([]float64{2.0, 0.5}, benchmarks.Sphere)
+	if v := evaluator(newIndividual(2.0, 0.5))[0]; math.Abs(v-2.0) > eps {
+		t.Errorf("wrong value: %v", v)
+	}
+}
+
+func TestNoise(t *testing.T) {
+	constant := func() float64 { return 1.0 }
+	if v := Noise([]func() float64{constant}, benchmarks.Sphere)(newIndividual(1.0, 1.0))[0]; math.Abs(v-3.0) > eps {
+		t.Errorf("wrong value: %v", v)
+	}
+	if v := Noise([]func() float64{nil}, benchmarks.Sphere)(newIndividual(1.0, 1.0))[0]; math.Abs(v-2.0) > eps {
+		t.Errorf("nil should be no noise: %v", v)
+	}
+	evaluator := Noise([]func() float64{GaussianNoise(1.0)}, benchmarks.Sphere)
+	sum, n := 0.0, 10000
+	for i := 0; i < n; i++ {
+		sum += evaluator(newIndividual(0.0, 0.0))[0]
+	}
+	if mean := sum / float64(n); math.Abs(mean) > 0.1 {
+		t.Errorf("the mean of the noise should be 0: %v", mean)
+	}
+}
+
+func TestBound(t *testing.T) {
+	b := bounds.NewBounds(-1.0, 1.0, bounds.Clip, 2)
+	ind := newIndividual(3.0, -0.5)
+	if v := Bound(b, benchmarks.Sphere)(ind)[0]; math.Abs(v-1.25) > eps {
+		t.Errorf("wrong value: %v", v)
+	}
+	if x := ind.GetChromosome().([]float64); x[0] != 3.0 {
+		t.Errorf("the individual shouldn't be modified: %v", x)
+	}
+}
+
+func TestComposition(t *testing.T) {
+	// the shifted rotated Rastrigin, the optimum is at the vector
+	vector := []float64{0.5, -0.3, 0.2, 0.1}
+	evaluator := Translate(vector, Rotate(RandomOrthogonalMatrix(4), benchmarks.Rastrigin))
+	if v := evaluator(newIndividual(vector...))[0]; math.Abs(v) > eps {
+		t.Errorf("the optimum should be 0: %v", v)
+	}
+	if v := evaluator(newIndividual(0.0, 0.0, 0.0, 0.0))[0]; v <= eps {
+		t.Errorf("the origin shouldn't be the optimum: %v", v)
+	}
+}
@@ -0,0 +1,525 @@
+package benchmarks
+
+import (
+	"math"
+
+	"github.com/sineatos/deag/base"
+)
+
+// The CEC 2009 multi-objective test problems UF1-UF10 (unconstrained) and CF1-CF10 (constrained).
+//
+// From: Q. Zhang, A. Zhou, S. Zhao, P. N. Suganthan, W. Liu and S. Tiwari. Multiobjective Optimization Test Instances for the CEC 2009 Special Session and Competition. Technical Report CES-487, University of Essex, 2008.
+//
+// The problems are defined for any number of variables n, which is 30 for UF1-UF10 and 10 for CF1-CF10 in the competition. The variables are indexed from 1 as in the report.
+// For the two-objective problems J1 = {j | j is odd and 2 <= j <= n} and J2 = {j | j is even and 2 <= j <= n}.
+// For the three-objective problems J1 = {j | 3 <= j <= n, j-1 is a multiple of 3}, J2 = {j | 3 <= j <= n, j-2 is a multiple of 3} and J3 = {j | 3 <= j <= n, j is a multiple of 3}.
+//
+// The constrained problems return the objectives and the constraint values, a constraint is satisfied if its value is non-negative.
+
+// cecGroups returns the 1-based indices in J1, ..., Jobj for n variables
+func cecGroups(n, obj int) [][]int {
+	groups := make([][]int, obj)
+	for j := obj; j <= n; j++ {
+		g := (j - 1) % obj
+		if obj == 2 {
+			g = (j + 1) % 2
+		}
+		groups[g] = append(groups[g], j)
+	}
+	return groups
+}
+
+// cecPlainSums returns sum(term(j, x_j)) for each group Ji
+func cecPlainSums(x []float64, obj int, term func(j int, xj float64) float64) []float64 {
+	sums := make([]float64, obj)
+	for i, group := range cecGroups(len(x), obj) {
+		for _, j := range group {
+			sums[i] += term(j, x[j-1])
+		}
+	}
+	return sums
+}
+
+// cecSums returns 2/|Ji| * sum(term(j, x_j)) for each group Ji
+func cecSums(x []float64, obj int, term func(j int, xj float64) float64) []float64 {
+	sums := cecPlainSums(x, obj, term)
+	for i, group := range cecGroups(len(x), obj) {
+		if len(group) > 0 {
+			sums[i] *= 2.0 / float64(len(group))
+		}
+	}
+	return sums
+}
+
+// cecSumsProducts returns 2/|Ji| * (4sum(y_j^2) - 2prod(cos(20y_j\pi/\sqrt{j})) + c) for each group Ji, where y_j = y(j, x_j)
+func cecSumsProducts(x []float64, obj int, c float64, y func(j int, xj float64) float64) []float64 {
+	groups := cecGroups(len(x), obj)
+	sums := make([]float64, obj)
+	for i, group := range groups {
+		sum, prod := 0.0, 1.0
+		for _, j := range group {
+			yj := y(j, x[j-1])
+			sum += yj * yj
+			prod *= math.Cos(20.0 * yj * math.Pi / math.Sqrt(float64(j)))
+		}
+		if len(group) > 0 {
+			sums[i] = 2.0 / float64(len(group)) * (4.0*sum - 2.0*prod + c)
+		}
+	}
+	return sums
+}
+
+// cecSine returns y_j = x_j - \sin(6\pi x_1 + j\pi/n)
+func cecSine(x []float64) func(j int, xj float64) float64 {
+	n := float64(len(x))
+	return func(j int, xj float64) float64 {
+		return xj - math.Sin(6.0*math.Pi*x[0]+float64(j)*math.Pi/n)
+	}
+}
+
+// cecPower returns y_j = x_j - x_1^{0.5(1 + 3(j-2)/(n-2))}
+func cecPower(x []float64) func(j int, xj float64) float64 {
+	n := float64(len(x))
+	return func(j int, xj float64) float64 {
+		return xj - math.Pow(x[0], 0.5*(1.0+3.0*float64(j-2)/(n-2.0)))
+	}
+}
+
+// cecSphere returns y_j = x_j - 2x_2\sin(2\pi x_1 + j\pi/n) of the three-objective problems
+func cecSphere(x []float64) func(j int, xj float64) float64 {
+	n := float64(len(x))
+	return func(j int, xj float64) float64 {
+		return xj - 2.0*x[1]*math.Sin(2.0*math.Pi*x[0]+float64(j)*math.Pi/n)
+	}
+}
+
+// cecSquare returns the term y(j, x_j)^2
+func cecSquare(y func(j int, xj float64) float64) func(j int, xj float64) float64 {
+	return func(j int, xj float64) float64 {
+		yj := y(j, xj)
+		return yj * yj
+	}
+}
+
+// cecRastrigin returns the term 2y(j, x_j)^2 - \cos(4\pi y(j, x_j)) + 1
+func cecRastrigin(y func(j int, xj float64) float64) func(j int, xj float64) float64 {
+	return func(j int, xj float64) float64 {
+		yj := y(j, xj)
+		return 2.0*yj*yj - math.Cos(4.0*math.Pi*yj) + 1.0
+	}
+}
+
+// cecSphereFront returns the points on the unit sphere of the three-objective problems with the sums added
+func cecSphereFront(x, sums []float64) []float64 {
+	c1, s1 := math.Cos(0.5*math.Pi*x[0]), math.Sin(0.5*math.Pi*x[0])
+	c2, s2 := math.Cos(0.5*math.Pi*x[1]), math.Sin(0.5*math.Pi*x[1])
+	return []float64{c1*c2 + sums[0], c1*s2 + sums[1], s1 + sums[2]}
+}
+
+// cecRatio returns the constraint of CF8-CF10, `(f_1^2 + f_2^2)/(1 - f_3^2) - a|\\sin(N\pi((f_1^2 - f_2^2)/(1 - f_3^2) + 1))| - 1`
+// The absolute value is used by CF8 only.
+func cecRatio(f []float64, a, N float64, abs bool) float64 {
+	den := 1.0 - f[2]*f[2]
+	s := math.Sin(N * math.Pi * ((f[0]*f[0]-f[1]*f[1])/den + 1.0))
+	if abs {
+		s = math.Abs(s)
+	}
+	return (f[0]*f[0]+f[1]*f[1])/den - a*s - 1.0
+}
+
+// cecSigmoid returns t/(1 + e^{4|t|})
+func cecSigmoid(t float64) float64 {
+	return t / (1.0 + math.Exp(4.0*math.Abs(t)))
+}
+
+// cecH2 is the function h_2 of CF4 and CF5
+func cecH2(t float64) float64 {
+	if t < 1.5*(1.0-math.Sqrt(2.0)/2.0) {
+		return math.Abs(t)
+	}
+	return 0.125 + (t-1.0)*(t-1.0)
+}
+
+// cecOscillate returns y_j = x_j - 0.8x_1\cos(6\pi x_1 + j\pi/n) for j in J1 and y_j = x_j - 0.8x_1\sin(6\pi x_1 + j\pi/n) for j in J2 of CF5-CF7
+func cecOscillate(x []float64) func(j int, xj float64) float64 {
+	n := float64(len(x))
+	return func(j int, xj float64) float64 {
+		angle := 6.0*math.Pi*x[0] + float64(j)*math.Pi/n
+		if j%2 == 1 {
+			return xj - 0.8*x[0]*math.Cos(angle)
+		}
+		return xj - 0.8*x[0]*math.Sin(angle)
+	}
+}
+
+// cec6Constraints returns the constraints of CF6 and CF7
+func cec6Constraints(x []float64) []float64 {
+	n := float64(len(x))
+	sqrtSign := func(v float64) float64 {
+		if v < 0.0 {
+			return -math.Sqrt(-v)
+		}
+		return math.Sqrt(v)
+	}
+	a := 1.0 - x[0]
+	c1 := x[1] - 0.8*x[0]*math.Sin(6.0*math.Pi*x[0]+2.0*math.Pi/n) - sqrtSign(0.5*a-a*a)
+	c2 := x[3] - 0.8*x[0]*math.Sin(6.0*math.Pi*x[0]+4.0*math.Pi/n) - sqrtSign(0.25*math.Sqrt(a)-0.5*a)
+	return []float64{c1, c2}
+}
+
+// UF1 multiobjective function. It returns a slice of 2 values.
+//
+// `x_1 \in [0, 1]`, `x_j \in [-1, 1]`. The Pareto front is `f_2 = 1 - \\sqrt{f_1}`, `f_1 \in [0, 1]`.
+//
+// `f_1 = x_1 + \\frac{2}{|J_1|} \\sum_{j \in J_1} (x_j - \\sin(6\pi x_1 + j\pi/n))^2`
+//
+// `f_2 = 1 - \\sqrt{x_1} + \\frac{2}{|J_2|} \\sum_{j \in J_2} (x_j - \\sin(6\pi x_1 + j\pi/n))^2`
+func UF1(individual *base.Float64Individual) []float64 {
+	x := individual.GetChromosome().([]float64)
+	sums := cecSums(x, 2, cecSquare(cecSine(x)))
+	return []float64{x[0] + sums[0], 1.0 - math.Sqrt(x[0]) + sums[1]}
+}
+
+// UF2 multiobjective function. It returns a slice of 2 values.
+//
+// `x_1 \in [0, 1]`, `x_j \in [-1, 1]`. The Pareto front is `f_2 = 1 - \\sqrt{f_1}`, `f_1 \in [0, 1]`.
+//
+// `f_1 = x_1 + \\frac{2}{|J_1|} \\sum_{j \in J_1} y_j^2`, `f_2 = 1 - \\sqrt{x_1} + \\frac{2}{|J_2|} \\sum_{j \in J_2} y_j^2`
+//
+// `y_j = x_j - (0.3x_1^2\\cos(24\pi x_1 + 4j\pi/n) + 0.6x_1)\\cos(6\pi x_1 + j\pi/n)` for `j \in J_1`, and `\\sin` replaces the last `\\cos` for `j \in J_2`.
+func UF2(individual *base.Float64Individual) []float64 {
+	x := individual.GetChromosome().([]float64)
+	n := float64(len(x))
+	sums := cecSums(x, 2, cecSquare(func(j int, xj float64) float64 {
+		amp := 0.3*x[0]*x[0]*math.Cos(24.0*math.Pi*x[0]+4.0*float64(j)*math.Pi/n) + 0.6*x[0]
+		angle := 6.0*math.Pi*x[0] + float64(j)*math.Pi/n
+		if j%2 == 1 {
+			return xj - amp*math.Cos(angle)
+		}
+		return xj - amp*math.Sin(angle)
+	}))
+	return []float64{x[0] + sums[0], 1.0 - math.Sqrt(x[0]) + sums[1]}
+}
+
+// UF3 multiobjective function. It returns a slice of 2 values.
+//
+// `x \in [0, 1]^n`. The Pareto front is `f_2 = 1 - \\sqrt{f_1}`, `f_1 \in [0, 1]`.
+//
+// `f_1 = x_1 + \\frac{2}{|J_1|} (4\\sum_{j \in J_1} y_j^2 - 2\\prod_{j \in J_1} \\cos(\\frac{20y_j\pi}{\\sqrt{j}}) + 2)`, `f_2 = 1 - \\sqrt{x_1} + \\frac{2}{|J_2|} (4\\sum_{j \in J_2} y_j^2 - 2\\prod_{j \in J_2} \\cos(\\frac{20y_j\pi}{\\sqrt{j}}) + 2)`
+//
+// `y_j = x_j - x_1^{0.5(1 + 3(j-2)/(n-2))}`
+func UF3(individual *base.Float64Individual) []float64 {
+	x := individual.GetChromosome().([]float64)
+	sums := cecSumsProducts(x, 2, 2.0, cecPower(x))
+	return []float64{x[0] + sums[0], 1.0 - math.Sqrt(x[0]) + sums[1]}
+}
+
+// UF4 multiobjective function. It returns a slice of 2 values.
+//
+// `x_1 \in [0, 1]`, `x_j \in [-2, 2]`. The Pareto front is `f_2 = 1 - f_1^2`, `f_1 \in [0, 1]`.
+//
+// `f_1 = x_1 + \\frac{2}{|J_1|} \\sum_{j \in J_1} h(y_j)`, `f_2 = 1 - x_1^2 + \\frac{2}{|J_2|} \\sum_{j \in J_2} h(y_j)`
+//
+// `y_j = x_j - \\sin(6\pi x_1 + j\pi/n)`, `h(t) = \\frac{|t|}{1 + e^{2|t|}}`
+func UF4(individual *base.Float64Individual) []float64 {
+	x := individual.GetChromosome().([]float64)
+	y := cecSine(x)
+	sums := cecSums(x, 2, func(j int, xj float64) float64 {
+		t := math.Abs(y(j, xj))
+		return t / (1.0 + math.Exp(2.0*t))
+	})
+	return []float64{x[0] + sums[0], 1.0 - x[0]*x[0] + sums[1]}
+}
+
+// UF5 multiobjective function. It returns a slice of 2 values.
+//
+// `x_1 \in [0, 1]`, `x_j \in [-1, 1]`. The Pareto front consists of the 21 points `(i/20, 1 - i/20)`, `i = 0, \\ldots, 20`.
+//
+// `f_1 = x_1 + (\\frac{1}{2N} + \\epsilon)|\\sin(2N\pi x_1)| + \\frac{2}{|J_1|} \\sum_{j \in J_1} h(y_j)`, `f_2 = 1 - x_1 + (\\frac{1}{2N} + \\epsilon)|\\sin(2N\pi x_1)| + \\frac{2}{|J_2|} \\sum_{j \in J_2} h(y_j)`
+//
+// `N = 10`, `\\epsilon = 0.1`, `y_j = x_j - \\sin(6\pi x_1 + j\pi/n)`, `h(t) = 2t^2 - \\cos(4\pi t) + 1`
+func UF5(individual *base.Float64Individual) []float64 {
+	x := individual.GetChromosome().([]float64)
+	N, epsilon := 10.0, 0.1
+	sums := cecSums(x, 2, cecRastrigin(cecSine(x)))
+	d := (0.5/N + epsilon) * math.Abs(math.Sin(2.0*N*math.Pi*x[0]))
+	return []float64{x[0] + d + sums[0], 1.0 - x[0] + d + sums[1]}
+}
+
+// UF6 multiobjective function. It returns a slice of 2 values.
+//
+// `x_1 \in [0, 1]`, `x_j \in [-1, 1]`. The Pareto front consists of the point (0, 1) and the segments `f_2 = 1 - f_1`, `f_1 \in [1/4, 1/2] \\cup [3/4, 1]`.
+//
+// `f_1 = x_1 + \\max(0, 2(\\frac{1}{2N} + \\epsilon)\\sin(2N\pi x_1)) + \\frac{2}{|J_1|} (4\\sum_{j \in J_1} y_j^2 - 2\\prod_{j \in J_1} \\cos(\\frac{20y_j\pi}{\\sqrt{j}}) + 2)`
+//
+// `f_2 = 1 - x_1 + \\max(0, 2(\\frac{1}{2N} + \\epsilon)\\sin(2N\pi x_1)) + \\frac{2}{|J_2|} (4\\sum_{j \in J_2} y_j^2 - 2\\prod_{j \in J_2} \\cos(\\frac{20y_j\pi}{\\sqrt{j}}) + 2)`
+//
+// `N = 2`, `\\epsilon = 0.1`, `y_j = x_j - \\sin(6\pi x_1 + j\pi/n)`
+func UF6(individual *base.Float64Individual) []float64 {
+	x := individual.GetChromosome().([]float64)
+	N, epsilon := 2.0, 0.1
+	sums := cecSumsProducts(x, 2, 2.0, cecSine(x))
+	d := math.Max(0.0, 2.0*(0.5/N+epsilon)*math.Sin(2.0*N*math.Pi*x[0]))
+	return []float64{x[0] + d + sums[0], 1.0 - x[0] + d + sums[1]}
+}
+
+// UF7 multiobjective function. It returns a slice of 2 values.
+//
+// `x_1 \in [0, 1]`, `x_j \in [-1, 1]`. The Pareto front is `f_2 = 1 - f_1`, `f_1 \in [0, 1]`.
+//
+// `f_1 = \\sqrt[5]{x_1} + \\frac{2}{|J_1|} \\sum_{j \in J_1} y_j^2`, `f_2 = 1 - \\sqrt[5]{x_1} + \\frac{2}{|J_2|} \\sum_{j \in J_2} y_j^2`
+//
+// `y_j = x_j - \\sin(6\pi x_1 + j\pi/n)`
+func UF7(individual *base.Float64Individual) []float64 {
+	x := individual.GetChromosome().([]float64)
+	sums := cecSums(x, 2, cecSquare(cecSine(x)))
+	r := math.Pow(x[0], 0.2)
+	return []float64{r + sums[0], 1.0 - r + sums[1]}
+}
+
+// UF8 multiobjective function. It returns a slice of 3 values.
+//
+// `x_1, x_2 \in [0, 1]`, `x_j \in [-2, 2]`. The Pareto front is `f_1^2 + f_2^2 + f_3^2 = 1`, `f_i \ge 0`.
+//
+// `f_1 = \\cos(0.5x_1\pi)\\cos(0.5x_2\pi) + \\frac{2}{|J_1|} \\sum_{j \in J_1} y_j^2`
+//
+// `f_2 = \\cos(0.5x_1\pi)\\sin(0.5x_2\pi) + \\frac{2}{|J_2|} \\sum_{j \in J_2} y_j^2`
+//
+// `f_3 = \\sin(0.5x_1\pi) + \\frac{2}{|J_3|} \\sum_{j \in J_3} y_j^2`
+//
+// `y_j = x_j - 2x_2\\sin(2\pi x_1 + j\pi/n)`
+func UF8(individual *base.Float64Individual) []float64 {
+	x := individual.GetChromosome().([]float64)
+	return cecSphereFront(x, cecSums(x, 3, cecSquare(cecSphere(x))))
+}
+
+// UF9 multiobjective function. It returns a slice of 3 values.
+//
+// `x_1, x_2 \in [0, 1]`, `x_j \in [-2, 2]`. The Pareto front consists of the two parts of the plane `f_1 + f_2 + f_3 = 1` where `f_1 \in [0, 1/4] \\cup [3/4, 1]`.
+//
+// `f_1 = 0.5(\\max(0, (1 + \\epsilon)(1 - 4(2x_1 - 1)^2)) + 2x_1)x_2 + \\frac{2}{|J_1|} \\sum_{j \in J_1} y_j^2`
+//
+// `f_2 = 0.5(\\max(0, (1 + \\epsilon)(1 - 4(2x_1 - 1)^2)) - 2x_1 + 2)x_2 + \\frac{2}{|J_2|} \\sum_{j \in J_2} y_j^2`
+//
+// `f_3 = 1 - x_2 + \\frac{2}{|J_3|} \\sum_{j \in J_3} y_j^2`
+//
+// `\\epsilon = 0.1`, `y_j = x_j - 2x_2\\sin(2\pi x_1 + j\pi/n)`
+func UF9(individual *base.Float64Individual) []float64 {
+	x := individual.GetChromosome().([]float64)
+	epsilon := 0.1
+	sums := cecSums(x, 3, cecSquare(cecSphere(x)))
+	d := math.Max(0.0, (1.0+epsilon)*(1.0-4.0*(2.0*x[0]-1.0)*(2.0*x[0]-1.0)))
+	return []float64{
+		0.5*(d+2.0*x[0])*x[1] + sums[0],
+		0.5*(d-2.0*x[0]+2.0)*x[1] + sums[1],
+		1.0 - x[1] + sums[2],
+	}
+}
+
+// UF10 multiobjective function. It returns a slice of 3 values.
+//
+// `x_1, x_2 \in [0, 1]`, `x_j \in [-2, 2]`. The Pareto front is `f_1^2 + f_2^2 + f_3^2 = 1`, `f_i \ge 0`.
+//
+// It is UF8 with the terms `4y_j^2 - \\cos(8\pi y_j) + 1` instead of `y_j^2`.
+func UF10(individual *base.Float64Individual) []float64 {
+	x := individual.GetChromosome().([]float64)
+	y := cecSphere(x)
+	return cecSphereFront(x, cecSums(x, 3, func(j int, xj float64) float64 {
+		yj := y(j, xj)
+		return 4.0*yj*yj - math.Cos(8.0*math.Pi*yj) + 1.0
+	}))
+}
+
+// CF1 constrained multiobjective function. It returns the slice of 2 objectives and the slice of 1 constraint.
+//
+// `x \in [0, 1]^n`. The Pareto front consists of the points `(i/20, 1 - i/20)`, `i = 0, \\ldots, 20`.
+//
+// `f_1 = x_1 + \\frac{2}{|J_1|} \\sum_{j \in J_1} y_j^2`, `f_2 = 1 - x_1 + \\frac{2}{|J_2|} \\sum_{j \in J_2} y_j^2`, `y_j = x_j - x_1^{0.5(1 + 3(j-2)/(n-2))}`
+//
+// `f_1 + f_2 - a|\\sin(N\pi(f_1 - f_2 + 1))| - 1 \ge 0`, `N = 10`, `a = 1`
+func CF1(individual *base.Float64Individual) ([]float64, []float64) {
+	x := individual.GetChromosome().([]float64)
+	N, a := 10.0, 1.0
+	sums := cecSums(x, 2, cecSquare(cecPower(x)))
+	f1, f2 := x[0]+sums[0], 1.0-x[0]+sums[1]
+	return []float64{f1, f2}, []float64{f1 + f2 - a*math.Abs(math.Sin(N*math.Pi*(f1-f2+1.0))) - 1.0}
+}
+
+// CF2 constrained multiobjective function. It returns the slice of 2 objectives and the slice of 1 constraint.
+//
+// `x_1 \in [0, 1]`, `x_j \in [-1, 1]`. The Pareto front is `f_2 = 1 - \\sqrt{f_1}`, `f_1 \in \\{0\\} \\cup [1/16, 1/4] \\cup [9/16, 1]`.
+//
+// `f_1 = x_1 + \\frac{2}{|J_1|} \\sum_{j \in J_1} (x_j - \\sin(6\pi x_1 + j\pi/n))^2`, `f_2 = 1 - \\sqrt{x_1} + \\frac{2}{|J_2|} \\sum_{j \in J_2} (x_j - \\cos(6\pi x_1 + j\pi/n))^2`
+//
+// `\\frac{t}{1 + e^{4|t|}} \ge 0`, `t = f_2 + \\sqrt{f_1} - a\\sin(N\pi(\\sqrt{f_1} - f_2 + 1)) - 1`, `N = 2`, `a = 1`
+func CF2(individual *base.Float64Individual) ([]float64, []float64) {
+	x := individual.GetChromosome().([]float64)
+	n := float64(len(x))
+	N, a := 2.0, 1.0
+	sums := cecSums(x, 2, cecSquare(func(j int, xj float64) float64 {
+		angle := 6.0*math.Pi*x[0] + float64(j)*math.Pi/n
+		if j%2 == 1 {
+			return xj - math.Sin(angle)
+		}
+		return xj - math.Cos(angle)
+	}))
+	f1, f2 := x[0]+sums[0], 1.0-math.Sqrt(x[0])+sums[1]
+	t := f2 + math.Sqrt(f1) - a*math.Sin(N*math.Pi*(math.Sqrt(f1)-f2+1.0)) - 1.0
+	return []float64{f1, f2}, []float64{cecSigmoid(t)}
+}
+
+// CF3 constrained multiobjective function. It returns the slice of 2 objectives and the slice of 1 constraint.
+//
+// `x_1 \in [0, 1]`, `x_j \in [-2, 2]`. The Pareto front is `f_2 = 1 - f_1^2`, `f_1 \in \\{0\\} \\cup [1/2, \\sqrt{1/2}] \\cup [\\sqrt{3/4}, 1]`.
+//
+// `f_1 = x_1 + \\frac{2}{|J_1|} (4\\sum_{j \in J_1} y_j^2 - 2\\prod_{j \in J_1} \\cos(\\frac{20y_j\pi}{\\sqrt{j}}) + 2)`, `f_2 = 1 - x_1^2 + \\frac{2}{|J_2|} (4\\sum_{j \in J_2} y_j^2 - 2\\prod_{j \in J_2} \\cos(\\frac{20y_j\pi}{\\sqrt{j}}) + 2)`
+//
+// `y_j = x_j - \\sin(6\pi x_1 + j\pi/n)`, `f_2 + f_1^2 - a\\sin(N\pi(f_1^2 - f_2 + 1)) - 1 \ge 0`, `N = 2`, `a = 1`
+func CF3(individual *base.Float64Individual) ([]float64, []float64) {
+	x := individual.GetChromosome().([]float64)
+	N, a := 2.0, 1.0
+	sums := cecSumsProducts(x, 2, 2.0, cecSine(x))
+	f1, f2 := x[0]+sums[0], 1.0-x[0]*x[0]+sums[1]
+	return []float64{f1, f2}, []float64{f2 + f1*f1 - a*math.Sin(N*math.Pi*(f1*f1-f2+1.0)) - 1.0}
+}
+
+// CF4 constrained multiobjective function. It returns the slice of 2 objectives and the slice of 1 constraint.
+//
+// `x_1 \in [0, 1]`, `x_j \in [-2, 2]`. The Pareto front is `f_2 = 1 - f_1` for `f_1 \in [0, 1/2]`, `f_2 = -f_1/2 + 3/4` for `f_1 \in [1/2, 3/4]` and `f_2 = 1 - f_1 + 1/8` for `f_1 \in [3/4, 1]`.
+//
+// `f_1 = x_1 + \\sum_{j \in J_1} h_j(y_j)`, `f_2 = 1 - x_1 + \\sum_{j \in J_2} h_j(y_j)`, `y_j = x_j - \\sin(6\pi x_1 + j\pi/n)`
+//
+// `h_2(t) = |t|` if `t < \\frac{3}{2}(1 - \\frac{\\sqrt{2}}{2})` else `0.125 + (t - 1)^2`, `h_j(t) = t^2` for `j > 2`
+//
+// `\\frac{t}{1 + e^{4|t|}} \ge 0`, `t = x_2 - \\sin(6\pi x_1 + 2\pi/n) - 0.5x_1 + 0.25`
+func CF4(individual *base.Float64Individual) ([]float64, []float64) {
+	x := individual.GetChromosome().([]float64)
+	n := float64(len(x))
+	y := cecSine(x)
+	sums := cecPlainSums(x, 2, func(j int, xj float64) float64 {
+		yj := y(j, xj)
+		if j == 2 {
+			return cecH2(yj)
+		}
+		return yj * yj
+	})
+	t := x[1] - math.Sin(6.0*math.Pi*x[0]+2.0*math.Pi/n) - 0.5*x[0] + 0.25
+	return []float64{x[0] + sums[0], 1.0 - x[0] + sums[1]}, []float64{cecSigmoid(t)}
+}
+
+// CF5 constrained multiobjective function. It returns the slice of 2 objectives and the slice of 1 constraint.
+//
+// `x_1 \in [0, 1]`, `x_j \in [-2, 2]`. The Pareto front is the same as CF4.
+//
+// `f_1 = x_1 + \\sum_{j \in J_1} h_j(y_j)`, `f_2 = 1 - x_1 + \\sum_{j \in J_2} h_j(y_j)`
+//
+// `y_j = x_j - 0.8x_1\\cos(6\pi x_1 + j\pi/n)` for `j \in J_1`, `y_j = x_j - 0.8x_1\\sin(6\pi x_1 + j\pi/n)` for `j \in J_2`
+//
+// `h_2` is the same as CF4, `h_j(t) = 2t^2 - \\cos(4\pi t) + 1` for `j > 2`
+//
+// `x_2 - 0.8x_1\\sin(6\pi x_1 + 2\pi/n) - 0.5x_1 + 0.25 \ge 0`
+func CF5(individual *base.Float64Individual) ([]float64, []float64) {
+	x := individual.GetChromosome().([]float64)
+	n := float64(len(x))
+	y := cecOscillate(x)
+	sums := cecPlainSums(x, 2, func(j int, xj float64) float64 {
+		yj := y(j, xj)
+		if j == 2 {
+			return cecH2(yj)
+		}
+		return 2.0*yj*yj - math.Cos(4.0*math.Pi*yj) + 1.0
+	})
+	c := x[1] - 0.8*x[0]*math.Sin(6.0*math.Pi*x[0]+2.0*math.Pi/n) - 0.5*x[0] + 0.25
+	return []float64{x[0] + sums[0], 1.0 - x[0] + sums[1]}, []float64{c}
+}
+
+// CF6 constrained multiobjective function. It returns the slice of 2 objectives and the slice of 2 constraints.
+//
+// `x_1 \in [0, 1]`, `x_j \in [-2, 2]`. The Pareto front is `f_2 = (1 - f_1)^2` for `f_1 \in [0, 1/2]`, `f_2 = (1 - f_1)/2` for `f_1 \in [1/2, 3/4]` and `f_2 = \\sqrt{1 - f_1}/4` for `f_1 \in [3/4, 1]`.
+//
+// `f_1 = x_1 + \\sum_{j \in J_1} y_j^2`, `f_2 = (1 - x_1)^2 + \\sum_{j \in J_2} y_j^2`, `y_j` is the same as CF5
+//
+// `x_2 - 0.8x_1\\sin(6\pi x_1 + 2\pi/n) - \\text{sign}(0.5(1 - x_1) - (1 - x_1)^2)\\sqrt{|0.5(1 - x_1) - (1 - x_1)^2|} \ge 0`
+//
+// `x_4 - 0.8x_1\\sin(6\pi x_1 + 4\pi/n) - \\text{sign}(0.25\\sqrt{1 - x_1} - 0.5(1 - x_1))\\sqrt{|0.25\\sqrt{1 - x_1} - 0.5(1 - x_1)|} \ge 0`
+func CF6(individual *base.Float64Individual) ([]float64, []float64) {
+	x := individual.GetChromosome().([]float64)
+	sums := cecPlainSums(x, 2, cecSquare(cecOscillate(x)))
+	return []float64{x[0] + sums[0], (1.0-x[0])*(1.0-x[0]) + sums[1]}, cec6Constraints(x)
+}
+
+// CF7 constrained multiobjective function. It returns the slice of 2 objectives and the slice of 2 constraints.
+//
+// `x_1 \in [0, 1]`, `x_j \in [-2, 2]`. The Pareto front and the constraints are the same as CF6.
+//
+// `f_1 = x_1 + \\sum_{j \in J_1} h_j(y_j)`, `f_2 = (1 - x_1)^2 + \\sum_{j \in J_2} h_j(y_j)`, `y_j` is the same as CF5
+//
+// `h_2(t) = h_4(t) = t^2`, `h_j(t) = 2t^2 - \\cos(4\pi t) + 1` for the other `j`
+func CF7(individual *base.Float64Individual) ([]float64, []float64) {
+	x := individual.GetChromosome().([]float64)
+	y := cecOscillate(x)
+	sums := cecPlainSums(x, 2, func(j int, xj float64) float64 {
+		yj := y(j, xj)
+		if j == 2 || j == 4 {
+			return yj * yj
+		}
+		return 2.0*yj*yj - math.Cos(4.0*math.Pi*yj) + 1.0
+	})
+	return []float64{x[0] + sums[0], (1.0-x[0])*(1.0-x[0]) + sums[1]}, cec6Constraints(x)
+}
+
+// CF8 constrained multiobjective function. It returns the slice of 3 objectives and the slice of 1 constraint.
+//
+// `x_1, x_2 \in [0, 1]`, `x_j \in [-4, 4]`. The Pareto front consists of the curves on the unit sphere where `f_1^2 = \\frac{i}{2N}(1 - f_3^2)`, `i = 0, \\ldots, 2N`.
+//
+// The objectives are the same as UF8.
+//
+// `\\frac{f_1^2 + f_2^2}{1 - f_3^2} - a|\\sin(N\pi(\\frac{f_1^2 - f_2^2}{1 - f_3^2} + 1))| - 1 \ge 0`, `N = 2`, `a = 4`
+func CF8(individual *base.Float64Individual) ([]float64, []float64) {
+	x := individual.GetChromosome().([]float64)
+	f := cecSphereFront(x, cecSums(x, 3, cecSquare(cecSphere(x))))
+	return f, []float64{cecRatio(f, 4.0, 2.0, true)}
+}
+
+// CF9 constrained multiobjective function. It returns the slice of 3 objectives and the slice of 1 constraint.
+//
+// `x_1, x_2 \in [0, 1]`, `x_j \in [-2, 2]`. The Pareto front is the part of the unit sphere where `f_1^2/(1 - f_3^2) \in [1/4, 1/2] \\cup [3/4, 1]`, and the line `f_1 = 0, f_2 = 0`.
+//
+// The objectives are the same as UF8.
+//
+// `\\frac{f_1^2 + f_2^2}{1 - f_3^2} - a\\sin(N\pi(\\frac{f_1^2 - f_2^2}{1 - f_3^2} + 1)) - 1 \ge 0`, `N = 2`, `a = 3`
+func CF9(individual *base.Float64Individual) ([]float64, []float64) {
+	x := individual.GetChromosome().([]float64)
+	f := cecSphereFront(x, cecSums(x, 3, cecSquare(cecSphere(x))))
+	return f, []float64{cecRatio(f, 3.0, 2.0, false)}
+}
+
+// CF10 constrained multiobjective function. It returns the slice of 3 objectives and the slice of 1 constraint.
+//
+// `x_1, x_2 \in [0, 1]`, `x_j \in [-2, 2]`. The Pareto front is the same as CF9.
+//
+// The objectives are the same as UF10 and the constraint is the same as CF9 with `a = 1`.
+func CF10(individual *base.Float64Individual) ([]float64, []float64) {
+	f := UF10(individual)
+	return f, []float64{cecRatio(f, 1.0, 2.0, false)}
+}
+
+// CFEvaluator returns the evaluator of the objectives of the constrained function cf
+func CFEvaluator(cf func(individual *base.Float64Individual) ([]float64, []float64)) Float64Evaluator {
+	return func(individual *base.Float64Individual) []float64 {
+		f, _ := cf(individual)
+		return f
+	}
+}
+
+// CFViolations returns the function computing the violations max(0, -c) of the constraints c of the constrained function cf
+func CFViolations(cf func(individual *base.Float64Individual) ([]float64, []float64)) func(individual *base.Float64Individual) []float64 {
+	return func(individual *base.Float64Individual) []float64 {
+		_, c := cf(individual)
+		violations := make([]float64, len(c))
+		for i, v := range c {
+			violations[i] = math.Max(0.0, -v)
+		}
+		return violations
+	}
+}
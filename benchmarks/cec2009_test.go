@@ -0,0 +1,130 @@
+package benchmarks
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+)
+
+// cecIndividual returns the individual of n variables, whose first variables are x and the others are given by y(j) where j is the 1-based index
+func cecIndividual(n int, x []float64, y func(x []float64, j int) float64) *base.Float64Individual {
+	values := make([]float64, n)
+	copy(values, x)
+	for j := len(x) + 1; j <= n; j++ {
+		values[j-1] = y(values, j)
+	}
+	return base.NewFloat64Individual(values, base.NewFitness([]float64{-1.0, -1.0}))
+}
+
+func sinePS(x []float64, j int) float64 {
+	return math.Sin(6.0*math.Pi*x[0] + float64(j)*math.Pi/float64(len(x)))
+}
+
+func powerPS(x []float64, j int) float64 {
+	return math.Pow(x[0], 0.5*(1.0+3.0*float64(j-2)/float64(len(x)-2)))
+}
+
+func spherePS(x []float64, j int) float64 {
+	return 2.0 * x[1] * math.Sin(2.0*math.Pi*x[0]+float64(j)*math.Pi/float64(len(x)))
+}
+
+func uf2PS(x []float64, j int) float64 {
+	n := float64(len(x))
+	amp := 0.3*x[0]*x[0]*math.Cos(24.0*math.Pi*x[0]+4.0*float64(j)*math.Pi/n) + 0.6*x[0]
+	if j%2 == 1 {
+		return amp * math.Cos(6.0*math.Pi*x[0]+float64(j)*math.Pi/n)
+	}
+	return amp * math.Sin(6.0*math.Pi*x[0]+float64(j)*math.Pi/n)
+}
+
+func checkFront(t *testing.T, name string, target, value float64) {
+	if math.Abs(target-value) > 1E-9 {
+		t.Errorf("%s: expected %v on the Pareto front, got %v", name, target, value)
+	}
+}
+
+func TestUF(t *testing.T) {
+	for _, x1 := range []float64{0.0, 0.3, 0.85} {
+		f := UF1(cecIndividual(30, []float64{x1}, sinePS))
+		checkFront(t, "UF1", 1.0-math.Sqrt(f[0]), f[1])
+		f = UF2(cecIndividual(30, []float64{x1}, uf2PS))
+		checkFront(t, "UF2", 1.0-math.Sqrt(f[0]), f[1])
+		f = UF3(cecIndividual(30, []float64{x1}, powerPS))
+		checkFront(t, "UF3", 1.0-math.Sqrt(f[0]), f[1])
+		f = UF4(cecIndividual(30, []float64{x1}, sinePS))
+		checkFront(t, "UF4", 1.0-f[0]*f[0], f[1])
+		f = UF7(cecIndividual(30, []float64{x1}, sinePS))
+		checkFront(t, "UF7", 1.0-f[0], f[1])
+		for _, x2 := range []float64{0.0, 0.5, 0.9} {
+			for name, uf := range map[string]Float64Evaluator{"UF8": UF8, "UF10": UF10} {
+				f = uf(cecIndividual(30, []float64{x1, x2}, spherePS))
+				checkFront(t, name, 1.0, f[0]*f[0]+f[1]*f[1]+f[2]*f[2])
+			}
+		}
+	}
+	// the Pareto optimal points of UF5 are at x1 = i/20
+	f := UF5(cecIndividual(30, []float64{0.35}, sinePS))
+	checkFront(t, "UF5", 0.35, f[0])
+	checkFront(t, "UF5", 0.65, f[1])
+	f = UF5(cecIndividual(30, []float64{0.36}, sinePS))
+	if f[0]+f[1] <= 1.0 {
+		t.Errorf("UF5: %v shouldn't be on the Pareto front", f)
+	}
+	f = UF6(cecIndividual(30, []float64{0.4}, sinePS))
+	checkFront(t, "UF6", 1.0, f[0]+f[1])
+	f = UF9(cecIndividual(30, []float64{0.1, 0.6}, spherePS))
+	checkFront(t, "UF9", 1.0, f[0]+f[1]+f[2])
+}
+
+func TestCF(t *testing.T) {
+	feasible := func(name string, c []float64) bool {
+		for _, v := range c {
+			if v < -1E-12 {
+				return false
+			}
+		}
+		return true
+	}
+	f, c := CF1(cecIndividual(10, []float64{0.25}, powerPS))
+	checkFront(t, "CF1", 0.75, f[1])
+	if !feasible("CF1", c) {
+		t.Errorf("CF1: the point on the Pareto front should be feasible: %v", c)
+	}
+	cf2PS := func(x []float64, j int) float64 {
+		if j%2 == 1 {
+			return sinePS(x, j)
+		}
+		return math.Cos(6.0*math.Pi*x[0] + float64(j)*math.Pi/float64(len(x)))
+	}
+	f, c = CF2(cecIndividual(10, []float64{0.15}, cf2PS))
+	checkFront(t, "CF2", 1.0-math.Sqrt(f[0]), f[1])
+	if !feasible("CF2", c) {
+		t.Errorf("CF2: the point on the Pareto front should be feasible: %v", c)
+	}
+	individual := cecIndividual(10, []float64{0.4}, cf2PS)
+	if v := CFViolations(CF2)(individual); len(v) != 1 || v[0] <= 0.0 {
+		t.Errorf("CF2: %v should be infeasible: %v", individual, v)
+	}
+	if f := CFEvaluator(CF2)(individual); len(f) != 2 {
+		t.Errorf("CF2: wrong objectives %v", f)
+	}
+	f, c = CF3(cecIndividual(10, []float64{0.6}, sinePS))
+	checkFront(t, "CF3", 1.0-f[0]*f[0], f[1])
+	if !feasible("CF3", c) {
+		t.Errorf("CF3: the point on the Pareto front should be feasible: %v", c)
+	}
+	for name, cf := range map[string]func(*base.Float64Individual) ([]float64, []float64){"CF8": CF8, "CF9": CF9, "CF10": CF10} {
+		f, c = cf(cecIndividual(10, []float64{0.3, 0.6}, spherePS))
+		checkFront(t, name, 1.0, f[0]*f[0]+f[1]*f[1]+f[2]*f[2])
+		if name != "CF8" && !feasible(name, c) {
+			t.Errorf("%s: the point on the Pareto front should be feasible: %v", name, c)
+		}
+	}
+	for name, cf := range map[string]func(*base.Float64Individual) ([]float64, []float64){"CF4": CF4, "CF5": CF5, "CF6": CF6, "CF7": CF7} {
+		f, c = cf(cecIndividual(10, []float64{0.5}, func(x []float64, j int) float64 { return 0.0 }))
+		if len(f) != 2 || len(c) == 0 {
+			t.Errorf("%s: wrong evaluation %v, %v", name, f, c)
+		}
+	}
+}
@@ -0,0 +1,698 @@
+package benchmarks
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/bounds"
+)
+
+// EqualityTolerance is the tolerance of the equality constraints, h(x) = 0 is regarded as satisfied if |h(x)| <= EqualityTolerance as in CEC 2006
+const EqualityTolerance float64 = 1E-4
+
+// ConstrainedProblem describes a constrained minimization problem:
+// minimize f(x) subject to g_i(x) <= 0, h_j(x) = 0 and low <= x <= up.
+// The box constraints aren't counted in the violations, they can be kept by the bounds returned by GetBounds.
+type ConstrainedProblem struct {
+	name         string
+	low          []float64
+	up           []float64
+	objective    func(x []float64) float64
+	inequalities func(x []float64) []float64
+	equalities   func(x []float64) []float64
+	optimum      float64
+	optimizer    []float64
+}
+
+// NewConstrainedProblem returns *ConstrainedProblem.
+// inequalities returns the values of g_i(x) and equalities returns the values of h_j(x), either of them can be nil if there is no such constraint.
+// optimum is the known optimal (or best known) value and optimizer is the solution of it, optimizer can be nil if it isn't known.
+func NewConstrainedProblem(name string, low, up []float64, objective func(x []float64) float64, inequalities, equalities func(x []float64) []float64, optimum float64, optimizer []float64) *ConstrainedProblem {
+	if len(low) != len(up) {
+		panic(fmt.Sprintf("The lower bounds and the upper bounds should be of the same size: %d != %d", len(low), len(up)))
+	}
+	return &ConstrainedProblem{
+		name:         name,
+		low:          low,
+		up:           up,
+		objective:    objective,
+		inequalities: inequalities,
+		equalities:   equalities,
+		optimum:      optimum,
+		optimizer:    optimizer,
+	}
+}
+
+// GetName returns the name of the problem
+func (cp *ConstrainedProblem) GetName() string {
+	return cp.name
+}
+
+// Dims returns the number of variables
+func (cp *ConstrainedProblem) Dims() int {
+	return len(cp.low)
+}
+
+// GetLow returns a copy of the lower bounds
+func (cp *ConstrainedProblem) GetLow() []float64 {
+	return append([]float64(nil), cp.low...)
+}
+
+// GetUp returns a copy of the upper bounds
+func (cp *ConstrainedProblem) GetUp() []float64 {
+	return append([]float64(nil), cp.up...)
+}
+
+// GetBounds returns the *bounds.Bounds of the box constraints repaired by handler
+func (cp *ConstrainedProblem) GetBounds(handler bounds.Handler) *bounds.Bounds {
+	return bounds.NewBounds(cp.GetLow(), cp.GetUp(), handler, cp.Dims())
+}
+
+// GetOptimum returns the known optimal value, it is the best known value if the optimum isn't proven
+func (cp *ConstrainedProblem) GetOptimum() float64 {
+	return cp.optimum
+}
+
+// GetOptimizer returns a copy of the solution of GetOptimum, it returns nil if the solution isn't known
+func (cp *ConstrainedProblem) GetOptimizer() []float64 {
+	if cp.optimizer == nil {
+		return nil
+	}
+	return append([]float64(nil), cp.optimizer...)
+}
+
+// Objective returns f(x)
+func (cp *ConstrainedProblem) Objective(x []float64) float64 {
+	return cp.objective(x)
+}
+
+// Constraints returns the values of g_i(x) and h_j(x)
+func (cp *ConstrainedProblem) Constraints(x []float64) (g, h []float64) {
+	if cp.inequalities != nil {
+		g = cp.inequalities(x)
+	}
+	if cp.equalities != nil {
+		h = cp.equalities(x)
+	}
+	return
+}
+
+// Violations returns the violations of the constraints, which are max(0, g_i(x)) followed by max(0, |h_j(x)| - EqualityTolerance)
+func (cp *ConstrainedProblem) Violations(x []float64) []float64 {
+	g, h := cp.Constraints(x)
+	ans := make([]float64, 0, len(g)+len(h))
+	for _, v := range g {
+		ans = append(ans, math.Max(0.0, v))
+	}
+	for _, v := range h {
+		ans = append(ans, math.Max(0.0, math.Abs(v)-EqualityTolerance))
+	}
+	return ans
+}
+
+// Violation returns the sum of Violations(x)
+func (cp *ConstrainedProblem) Violation(x []float64) float64 {
+	ans := 0.0
+	for _, v := range cp.Violations(x) {
+		ans += v
+	}
+	return ans
+}
+
+// Evaluate is the Float64Evaluator of the objective
+func (cp *ConstrainedProblem) Evaluate(individual *base.Float64Individual) []float64 {
+	return []float64{cp.objective(individual.GetChromosome().([]float64))}
+}
+
+// EvaluateViolations returns the violations of individual, see Violations
+func (cp *ConstrainedProblem) EvaluateViolations(individual *base.Float64Individual) []float64 {
+	return cp.Violations(individual.GetChromosome().([]float64))
+}
+
+// IsFeasible returns if individual satisfies all the constraints except the box constraints,
+// it can be used as the isFeasible of constraint.NewClosestValidPenalty
+func (cp *ConstrainedProblem) IsFeasible(individual *base.Float64Individual) bool {
+	return cp.Violation(individual.GetChromosome().([]float64)) == 0.0
+}
+
+// Benchmark returns the *Benchmark of the objective of cp in its bounds with the known optimum,
+// the constraints aren't evaluated by the Benchmark, they are given by Violations
+func (cp *ConstrainedProblem) Benchmark() *Benchmark {
+	var optimizer OptimizerFunc
+	if cp.optimizer != nil {
+		optimizer = func(dim int) interface{} { return cp.GetOptimizer() }
+	}
+	return NewBenchmark(cp.name, Float64Evaluator(cp.Evaluate), 1, false).SetDims(cp.Dims(), cp.Dims(), nil).
+		SetBounds(func(dim int) ([]float64, []float64) { return cp.GetLow(), cp.GetUp() }).
+		SetOptimum(constantOptimum(cp.optimum), optimizer)
+}
+
+var constrainedProblems = make(map[string]*ConstrainedProblem)
+
+// RegisterConstrainedProblem registers cp by its name, which is used by GetConstrainedProblem,
+// and registers cp.Benchmark() by the same name, which is used by GetBenchmark
+func RegisterConstrainedProblem(cp *ConstrainedProblem) {
+	constrainedProblems[cp.name] = cp
+	RegisterBenchmark(cp.Benchmark())
+}
+
+// GetConstrainedProblem returns the registered ConstrainedProblem named name, such as "g01" and "g24", see ConstrainedProblemNames
+func GetConstrainedProblem(name string) *ConstrainedProblem {
+	cp, ok := constrainedProblems[name]
+	if !ok {
+		panic(fmt.Sprintf("Unknown constrained benchmark: %s", name))
+	}
+	return cp
+}
+
+// ConstrainedProblemNames returns the sorted names of the registered constrained problems
+func ConstrainedProblemNames() []string {
+	names := make([]string, 0, len(constrainedProblems))
+	for name := range constrainedProblems {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// values collects the values as a slice
+func values(vs ...float64) []float64 {
+	return vs
+}
+
+// init registers G01-G24 of the CEC 2006 special session on constrained real-parameter optimization [Liang2006],
+// all of them are minimization problems.
+//
+// [Liang2006] Liang, Runarsson, Mezura-Montes, Clerc, Suganthan, Coello Coello and Deb, "Problem definitions and evaluation criteria for the CEC 2006 special session on constrained real-parameter optimization", 2006.
+func init() {
+	RegisterConstrainedProblem(NewConstrainedProblem("g01",
+		repeat(0.0, 13), append(append(repeat(1.0, 9), 100.0, 100.0, 100.0), 1.0),
+		func(x []float64) float64 {
+			f := 0.0
+			for i := 0; i < 4; i++ {
+				f += 5.0*x[i] - 5.0*x[i]*x[i]
+			}
+			for i := 4; i < 13; i++ {
+				f -= x[i]
+			}
+			return f
+		}, func(x []float64) []float64 {
+			return values(
+				2.0*x[0]+2.0*x[1]+x[9]+x[10]-10.0,
+				2.0*x[0]+2.0*x[2]+x[9]+x[11]-10.0,
+				2.0*x[1]+2.0*x[2]+x[10]+x[11]-10.0,
+				-8.0*x[0]+x[9],
+				-8.0*x[1]+x[10],
+				-8.0*x[2]+x[11],
+				-2.0*x[3]-x[4]+x[9],
+				-2.0*x[5]-x[6]+x[10],
+				-2.0*x[7]-x[8]+x[11],
+			)
+		}, nil, -15.0, values(1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 3, 3, 1)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g02", repeat(0.0, 20), repeat(10.0, 20),
+		func(x []float64) float64 {
+			sum4, prod2, sumI := 0.0, 1.0, 0.0
+			for i, v := range x {
+				c := math.Cos(v)
+				sum4 += c * c * c * c
+				prod2 *= c * c
+				sumI += float64(i+1) * v * v
+			}
+			return -math.Abs((sum4 - 2.0*prod2) / math.Sqrt(sumI))
+		}, func(x []float64) []float64 {
+			prod, sum := 1.0, 0.0
+			for _, v := range x {
+				prod *= v
+				sum += v
+			}
+			return values(0.75-prod, sum-7.5*float64(len(x)))
+		}, nil, -0.80361910412559, values(
+			3.16246061572185, 3.12833142812967, 3.09479212988791, 3.06145059523469, 3.02792915885555,
+			2.99382606701730, 2.95866871765285, 2.92184227312450, 0.49482511456933, 0.48835711005490,
+			0.48231642711865, 0.47664475092742, 0.47129550835493, 0.46623099264167, 0.46142004984199,
+			0.45683664767217, 0.45245876903267, 0.44826762241853, 0.44424700958760, 0.44038285956317)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g03", repeat(0.0, 10), repeat(1.0, 10),
+		func(x []float64) float64 {
+			n := float64(len(x))
+			f := math.Pow(math.Sqrt(n), n)
+			for _, v := range x {
+				f *= v
+			}
+			return -f
+		}, nil, func(x []float64) []float64 {
+			h := -1.0
+			for _, v := range x {
+				h += v * v
+			}
+			return values(h)
+		}, -1.00050010001000, repeat(0.31624357647283069, 10)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g04",
+		values(78.0, 33.0, 27.0, 27.0, 27.0), values(102.0, 45.0, 45.0, 45.0, 45.0),
+		func(x []float64) float64 {
+			return 5.3578547*x[2]*x[2] + 0.8356891*x[0]*x[4] + 37.293239*x[0] - 40792.141
+		}, func(x []float64) []float64 {
+			u := 85.334407 + 0.0056858*x[1]*x[4] + 0.0006262*x[0]*x[3] - 0.0022053*x[2]*x[4]
+			v := 80.51249 + 0.0071317*x[1]*x[4] + 0.0029955*x[0]*x[1] + 0.0021813*x[2]*x[2]
+			w := 9.300961 + 0.0047026*x[2]*x[4] + 0.0012547*x[0]*x[2] + 0.0019085*x[2]*x[3]
+			return values(u-92.0, -u, v-110.0, -v+90.0, w-25.0, -w+20.0)
+		}, nil, -30665.538671783317, values(78.0, 33.0, 29.9952560256815985, 45.0, 36.7758129057882073)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g05",
+		values(0.0, 0.0, -0.55, -0.55), values(1200.0, 1200.0, 0.55, 0.55),
+		func(x []float64) float64 {
+			return 3.0*x[0] + 0.000001*x[0]*x[0]*x[0] + 2.0*x[1] + 0.000002/3.0*x[1]*x[1]*x[1]
+		}, func(x []float64) []float64 {
+			return values(-x[3]+x[2]-0.55, -x[2]+x[3]-0.55)
+		}, func(x []float64) []float64 {
+			return values(
+				1000.0*math.Sin(-x[2]-0.25)+1000.0*math.Sin(-x[3]-0.25)+894.8-x[0],
+				1000.0*math.Sin(x[2]-0.25)+1000.0*math.Sin(x[2]-x[3]-0.25)+894.8-x[1],
+				1000.0*math.Sin(x[3]-0.25)+1000.0*math.Sin(x[3]-x[2]-0.25)+1294.8,
+			)
+		}, 5126.4967140071, values(679.945148297028709, 1026.06697600004691, 0.118876369094410433, -0.39623348521517826)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g06", values(13.0, 0.0), values(100.0, 100.0),
+		func(x []float64) float64 {
+			return math.Pow(x[0]-10.0, 3.0) + math.Pow(x[1]-20.0, 3.0)
+		}, func(x []float64) []float64 {
+			return values(
+				-(x[0]-5.0)*(x[0]-5.0)-(x[1]-5.0)*(x[1]-5.0)+100.0,
+				(x[0]-6.0)*(x[0]-6.0)+(x[1]-5.0)*(x[1]-5.0)-82.81,
+			)
+		}, nil, -6961.81387558015, values(14.09500000000000064, 0.8429607892154795668)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g07", repeat(-10.0, 10), repeat(10.0, 10),
+		func(x []float64) float64 {
+			return x[0]*x[0] + x[1]*x[1] + x[0]*x[1] - 14.0*x[0] - 16.0*x[1] + (x[2]-10.0)*(x[2]-10.0) +
+				4.0*(x[3]-5.0)*(x[3]-5.0) + (x[4]-3.0)*(x[4]-3.0) + 2.0*(x[5]-1.0)*(x[5]-1.0) + 5.0*x[6]*x[6] +
+				7.0*(x[7]-11.0)*(x[7]-11.0) + 2.0*(x[8]-10.0)*(x[8]-10.0) + (x[9]-7.0)*(x[9]-7.0) + 45.0
+		}, func(x []float64) []float64 {
+			return values(
+				-105.0+4.0*x[0]+5.0*x[1]-3.0*x[6]+9.0*x[7],
+				10.0*x[0]-8.0*x[1]-17.0*x[6]+2.0*x[7],
+				-8.0*x[0]+2.0*x[1]+5.0*x[8]-2.0*x[9]-12.0,
+				3.0*(x[0]-2.0)*(x[0]-2.0)+4.0*(x[1]-3.0)*(x[1]-3.0)+2.0*x[2]*x[2]-7.0*x[3]-120.0,
+				5.0*x[0]*x[0]+8.0*x[1]+(x[2]-6.0)*(x[2]-6.0)-2.0*x[3]-40.0,
+				x[0]*x[0]+2.0*(x[1]-2.0)*(x[1]-2.0)-2.0*x[0]*x[1]+14.0*x[4]-6.0*x[5],
+				0.5*(x[0]-8.0)*(x[0]-8.0)+2.0*(x[1]-4.0)*(x[1]-4.0)+3.0*x[4]*x[4]-x[5]-30.0,
+				-3.0*x[0]+6.0*x[1]+12.0*(x[8]-8.0)*(x[8]-8.0)-7.0*x[9],
+			)
+		}, nil, 24.30620906818, values(2.17199634142692, 2.3636830416034, 8.77392573913157, 5.09598443745173, 0.990654756560493,
+			1.43057392853463, 1.32164415364306, 9.82872576524495, 8.2800915887356, 8.3759266477347)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g08", repeat(0.0, 2), repeat(10.0, 2),
+		func(x []float64) float64 {
+			return -math.Pow(math.Sin(2.0*math.Pi*x[0]), 3.0) * math.Sin(2.0*math.Pi*x[1]) / (x[0] * x[0] * x[0] * (x[0] + x[1]))
+		}, func(x []float64) []float64 {
+			return values(x[0]*x[0]-x[1]+1.0, 1.0-x[0]+(x[1]-4.0)*(x[1]-4.0))
+		}, nil, -0.0958250414180359, values(1.22797135260752599, 4.24537336612274885)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g09", repeat(-10.0, 7), repeat(10.0, 7),
+		func(x []float64) float64 {
+			return (x[0]-10.0)*(x[0]-10.0) + 5.0*(x[1]-12.0)*(x[1]-12.0) + math.Pow(x[2], 4.0) +
+				3.0*(x[3]-11.0)*(x[3]-11.0) + 10.0*math.Pow(x[4], 6.0) + 7.0*x[5]*x[5] + math.Pow(x[6], 4.0) -
+				4.0*x[5]*x[6] - 10.0*x[5] - 8.0*x[6]
+		}, func(x []float64) []float64 {
+			return values(
+				-127.0+2.0*x[0]*x[0]+3.0*math.Pow(x[1], 4.0)+x[2]+4.0*x[3]*x[3]+5.0*x[4],
+				-282.0+7.0*x[0]+3.0*x[1]+10.0*x[2]*x[2]+x[3]-x[4],
+				-196.0+23.0*x[0]+x[1]*x[1]+6.0*x[5]*x[5]-8.0*x[6],
+				4.0*x[0]*x[0]+x[1]*x[1]-3.0*x[0]*x[1]+2.0*x[2]*x[2]+5.0*x[5]-11.0*x[6],
+			)
+		}, nil, 680.630057374402, values(2.33049935147405174, 1.95137236847114592, -0.477541399510615805, 4.36572624923625874,
+			-0.624486959100388983, 1.03813099410962173, 1.5942266780671519)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g10",
+		append(values(100.0, 1000.0, 1000.0), repeat(10.0, 5)...), append(repeat(10000.0, 3), repeat(1000.0, 5)...),
+		func(x []float64) float64 {
+			return x[0] + x[1] + x[2]
+		}, func(x []float64) []float64 {
+			return values(
+				-1.0+0.0025*(x[3]+x[5]),
+				-1.0+0.0025*(x[4]+x[6]-x[3]),
+				-1.0+0.01*(x[7]-x[4]),
+				-x[0]*x[5]+833.33252*x[3]+100.0*x[0]-83333.333,
+				-x[1]*x[6]+1250.0*x[4]+x[1]*x[3]-1250.0*x[3],
+				-x[2]*x[7]+1250000.0+x[2]*x[4]-2500.0*x[4],
+			)
+		}, nil, 7049.24802052867, values(579.306685017979589, 1359.97067807935605, 5109.97065743133317, 182.01769963061534,
+			295.601173702746792, 217.982300369384632, 286.41652592786852, 395.601173702746735)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g11", repeat(-1.0, 2), repeat(1.0, 2),
+		func(x []float64) float64 {
+			return x[0]*x[0] + (x[1]-1.0)*(x[1]-1.0)
+		}, nil, func(x []float64) []float64 {
+			return values(x[1] - x[0]*x[0])
+		}, 0.7499, values(-0.707036070037170616, 0.500000004333606807)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g12", repeat(0.0, 3), repeat(10.0, 3),
+		func(x []float64) float64 {
+			return -(100.0 - (x[0]-5.0)*(x[0]-5.0) - (x[1]-5.0)*(x[1]-5.0) - (x[2]-5.0)*(x[2]-5.0)) / 100.0
+		}, func(x []float64) []float64 {
+			// the feasible region is the union of 9^3 disjoint spheres, so the constraint is the minimum over the spheres
+			g := math.Inf(1)
+			for p := 1.0; p <= 9.0; p++ {
+				for q := 1.0; q <= 9.0; q++ {
+					for r := 1.0; r <= 9.0; r++ {
+						g = math.Min(g, (x[0]-p)*(x[0]-p)+(x[1]-q)*(x[1]-q)+(x[2]-r)*(x[2]-r)-0.0625)
+					}
+				}
+			}
+			return values(g)
+		}, nil, -1.0, values(5.0, 5.0, 5.0)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g13",
+		values(-2.3, -2.3, -3.2, -3.2, -3.2), values(2.3, 2.3, 3.2, 3.2, 3.2),
+		func(x []float64) float64 {
+			return math.Exp(x[0] * x[1] * x[2] * x[3] * x[4])
+		}, nil, func(x []float64) []float64 {
+			return values(
+				x[0]*x[0]+x[1]*x[1]+x[2]*x[2]+x[3]*x[3]+x[4]*x[4]-10.0,
+				x[1]*x[2]-5.0*x[3]*x[4],
+				x[0]*x[0]*x[0]+x[1]*x[1]*x[1]+1.0,
+			)
+		}, 0.053941514041898, values(-1.71714224003, 1.59572124049468, 1.8272502406271, -0.763659881912867, -0.76365986736498)))
+
+	g14c := values(-6.089, -17.164, -34.054, -5.914, -24.721, -14.986, -24.1, -10.708, -26.662, -22.179)
+	RegisterConstrainedProblem(NewConstrainedProblem("g14", repeat(0.0, 10), repeat(10.0, 10),
+		func(x []float64) float64 {
+			sum := 0.0
+			for _, v := range x {
+				sum += v
+			}
+			f := 0.0
+			for i, v := range x {
+				f += v * (g14c[i] + math.Log(v/sum))
+			}
+			return f
+		}, nil, func(x []float64) []float64 {
+			return values(
+				x[0]+2.0*x[1]+2.0*x[2]+x[5]+x[9]-2.0,
+				x[3]+2.0*x[4]+x[5]+x[6]-1.0,
+				x[2]+x[6]+x[7]+2.0*x[8]+x[9]-1.0,
+			)
+		}, -47.7648884594915, values(0.0406684113216282, 0.147721240492452, 0.783205732104114, 0.00141433931889084, 0.485293636780388,
+			0.000693183051556082, 0.0274052040687766, 0.0179509660214818, 0.0373268186859717, 0.0968844604336845)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g15", repeat(0.0, 3), repeat(10.0, 3),
+		func(x []float64) float64 {
+			return 1000.0 - x[0]*x[0] - 2.0*x[1]*x[1] - x[2]*x[2] - x[0]*x[1] - x[0]*x[2]
+		}, nil, func(x []float64) []float64 {
+			return values(x[0]*x[0]+x[1]*x[1]+x[2]*x[2]-25.0, 8.0*x[0]+14.0*x[1]+7.0*x[2]-56.0)
+		}, 961.715022289961, values(3.51212812611795133, 0.216987510429556135, 3.55217854929179921)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g16",
+		values(704.4148, 68.6, 0.0, 193.0, 25.0), values(906.3855, 288.88, 134.75, 287.0966, 84.1988),
+		func(x []float64) float64 {
+			y, c := g16Terms(x)
+			return 0.000117*y[14] + 0.1365 + 0.00002358*y[13] + 0.000001502*y[16] + 0.0321*y[12] + 0.004324*y[5] +
+				0.0001*c[15]/c[16] + 37.48*y[2]/c[12] - 0.0000005843*y[17]
+		}, func(x []float64) []float64 {
+			y, c := g16Terms(x)
+			g := values(
+				0.28/0.72*y[5]-y[4],
+				x[2]-1.5*x[1],
+				3496.0*y[2]/c[12]-21.0,
+				110.6+y[1]-62212.0/c[17],
+			)
+			// the lower and upper limits of y1-y17
+			limits := [][2]float64{{213.1, 405.23}, {17.505, 1053.6667}, {11.275, 35.03}, {214.228, 665.585},
+				{7.458, 584.463}, {0.961, 265.916}, {1.612, 7.046}, {0.146, 0.222}, {107.99, 273.366},
+				{922.693, 1286.105}, {926.832, 1444.046}, {18.766, 537.141}, {1072.163, 3247.039},
+				{8961.448, 26844.086}, {0.063, 0.386}, {71084.33, 140000.0}, {2802713.0, 12146108.0}}
+			for i, l := range limits {
+				g = append(g, l[0]-y[i+1], y[i+1]-l[1])
+			}
+			return g
+		}, nil, -1.90515525853479, values(705.174537070090537, 68.5999999999999943, 102.899999999999991, 282.324931593660324, 37.5841164258054832)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g17",
+		values(0.0, 0.0, 340.0, 340.0, -1000.0, 0.0), values(400.0, 1000.0, 420.0, 420.0, 1000.0, 0.5236),
+		func(x []float64) float64 {
+			f1 := 30.0 * x[0]
+			if x[0] >= 300.0 {
+				f1 = 31.0 * x[0]
+			}
+			f2 := 28.0 * x[1]
+			if x[1] >= 200.0 {
+				f2 = 30.0 * x[1]
+			} else if x[1] >= 100.0 {
+				f2 = 29.0 * x[1]
+			}
+			return f1 + f2
+		}, nil, func(x []float64) []float64 {
+			a, b := x[2]*x[3]/131.078, 0.90798/131.078
+			return values(
+				-x[0]+300.0-a*math.Cos(1.48477-x[5])+b*x[2]*x[2]*math.Cos(1.47588),
+				-x[1]-a*math.Cos(1.48477+x[5])+b*x[3]*x[3]*math.Cos(1.47588),
+				-x[4]-a*math.Sin(1.48477+x[5])+b*x[3]*x[3]*math.Sin(1.47588),
+				200.0-a*math.Sin(1.48477-x[5])+b*x[2]*x[2]*math.Sin(1.47588),
+			)
+		}, 8853.53967480648, values(201.784467214523659, 99.9999999999999005, 383.071034852773266, 420.0, -10.9076584514292652, 0.0731482312084287128)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g18", append(repeat(-10.0, 8), 0.0), append(repeat(10.0, 8), 20.0),
+		func(x []float64) float64 {
+			return -0.5 * (x[0]*x[3] - x[1]*x[2] + x[2]*x[8] - x[4]*x[8] + x[4]*x[7] - x[5]*x[6])
+		}, func(x []float64) []float64 {
+			sq := func(v float64) float64 { return v * v }
+			return values(
+				sq(x[2])+sq(x[3])-1.0,
+				sq(x[8])-1.0,
+				sq(x[4])+sq(x[5])-1.0,
+				sq(x[0])+sq(x[1]-x[8])-1.0,
+				sq(x[0]-x[4])+sq(x[1]-x[5])-1.0,
+				sq(x[0]-x[6])+sq(x[1]-x[7])-1.0,
+				sq(x[2]-x[4])+sq(x[3]-x[5])-1.0,
+				sq(x[2]-x[6])+sq(x[3]-x[7])-1.0,
+				sq(x[6])+sq(x[7]-x[8])-1.0,
+				x[1]*x[2]-x[0]*x[3],
+				-x[2]*x[8],
+				x[4]*x[8],
+				x[5]*x[6]-x[4]*x[7],
+			)
+		}, nil, -0.866025403784439, values(-0.657776192427943163, -0.153418773482438542, 0.323413871675240938, -0.946257611651304398,
+			-0.657776194376798906, -0.753213434632691414, 0.323413874123576972, -0.346462947962331735, 0.59979466285217542)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g19", repeat(0.0, 15), repeat(10.0, 15),
+		func(x []float64) float64 {
+			f := 0.0
+			for j := 0; j < 5; j++ {
+				for i := 0; i < 5; i++ {
+					f += g19c[i][j] * x[10+i] * x[10+j]
+				}
+				f += 2.0 * g19d[j] * x[10+j] * x[10+j] * x[10+j]
+			}
+			for i := 0; i < 10; i++ {
+				f -= g19b[i] * x[i]
+			}
+			return f
+		}, func(x []float64) []float64 {
+			g := make([]float64, 5)
+			for j := range g {
+				g[j] = -3.0*g19d[j]*x[10+j]*x[10+j] - g19e[j]
+				for i := 0; i < 5; i++ {
+					g[j] -= 2.0 * g19c[i][j] * x[10+i]
+				}
+				for i := 0; i < 10; i++ {
+					g[j] += g19a[i][j] * x[i]
+				}
+			}
+			return g
+		}, nil, 32.6555929502463, values(1.66991341326291344e-17, 3.95378229282456509e-16, 3.94599045143233784, 1.06036597479721211e-16,
+			3.2831773458454161, 9.99999999999999822, 1.12829414671605333e-17, 1.2026194599794709e-17, 2.50706276000769697e-15,
+			2.24624122987970677e-15, 0.370764847417013987, 0.278456024942955571, 0.523838487672241171, 0.388620152510322781,
+			0.298156764974678579)))
+
+	// no feasible solution of G20 is known, the optimum is the objective of the best known slightly infeasible solution
+	RegisterConstrainedProblem(NewConstrainedProblem("g20", repeat(0.0, 24), repeat(10.0, 24),
+		func(x []float64) float64 {
+			f := 0.0
+			for i, v := range x {
+				f += g20a[i] * v
+			}
+			return f
+		}, func(x []float64) []float64 {
+			sum := 0.0
+			for _, v := range x {
+				sum += v
+			}
+			g := make([]float64, 6)
+			for i := 0; i < 3; i++ {
+				g[i] = (x[i] + x[i+12]) / (sum + g20e[i])
+				g[i+3] = (x[i+6] + x[i+18]) / (sum + g20e[i+3])
+			}
+			return g
+		}, func(x []float64) []float64 {
+			sum, s1, s2, s3 := 0.0, 0.0, 0.0, 0.0
+			for i, v := range x {
+				sum += v
+				if i < 12 {
+					s1 += v / g20b[i]
+					s3 += v / g20d[i]
+				} else {
+					s2 += v / g20b[i]
+				}
+			}
+			h := make([]float64, 14)
+			for i := 0; i < 12; i++ {
+				h[i] = x[i+12]/(g20b[i+12]*s2) - g20c[i]*x[i]/(40.0*g20b[i]*s1)
+			}
+			h[12] = sum - 1.0
+			h[13] = s3 + 0.7302*530.0*14.7/40.0*s2 - 1.671
+			return h
+		}, 0.2049794002, nil))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g21",
+		values(0.0, 0.0, 0.0, 100.0, 6.3, 5.9, 4.5), values(1000.0, 40.0, 40.0, 300.0, 6.7, 6.4, 6.25),
+		func(x []float64) float64 {
+			return x[0]
+		}, func(x []float64) []float64 {
+			return values(-x[0] + 35.0*math.Pow(x[1], 0.6) + 35.0*math.Pow(x[2], 0.6))
+		}, func(x []float64) []float64 {
+			return values(
+				-300.0*x[2]+7500.0*x[4]-7500.0*x[5]-25.0*x[3]*x[4]+25.0*x[3]*x[5]+x[2]*x[3],
+				100.0*x[1]+155.365*x[3]+2500.0*x[6]-x[1]*x[3]-25.0*x[3]*x[6]-15536.5,
+				-x[4]+math.Log(-x[3]+900.0),
+				-x[5]+math.Log(x[3]+300.0),
+				-x[6]+math.Log(-2.0*x[3]+700.0),
+			)
+		}, 193.724510070035, values(193.724510070034967, 5.56944131553368433e-27, 17.3191887294084914, 100.047897801386839,
+			6.68445185362377892, 5.99168428444264833, 6.21451648886070451)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g22",
+		append(append(append(values(0.0, 0.0, 0.0, 0.0, 0.0, 0.0, 0.0, 100.0, 100.0, 100.01, 100.0, 100.0), repeat(0.0, 3)...), 0.01, 0.01), repeat(-4.7, 5)...),
+		append(append(append(values(20000.0, 1E6, 1E6, 1E6, 4E7, 4E7, 4E7, 299.99, 399.99, 300.0, 400.0, 600.0), repeat(500.0, 3)...), 300.0, 400.0), repeat(6.25, 5)...),
+		func(x []float64) float64 {
+			return x[0]
+		}, func(x []float64) []float64 {
+			return values(-x[0] + math.Pow(x[1], 0.6) + math.Pow(x[2], 0.6) + math.Pow(x[3], 0.6))
+		}, func(x []float64) []float64 {
+			return values(
+				x[4]-100000.0*x[7]+1E7,
+				x[5]+100000.0*x[7]-100000.0*x[8],
+				x[6]+100000.0*x[8]-5E7,
+				x[4]+100000.0*x[9]-3.3E7,
+				x[5]+100000.0*x[10]-4.4E7,
+				x[6]+100000.0*x[11]-6.6E7,
+				x[4]-120.0*x[1]*x[12],
+				x[5]-80.0*x[2]*x[13],
+				x[6]-40.0*x[3]*x[14],
+				x[7]-x[10]+x[15],
+				x[8]-x[11]+x[16],
+				-x[17]+math.Log(x[9]-100.0),
+				-x[18]+math.Log(-x[7]+300.0),
+				-x[19]+math.Log(x[15]),
+				-x[20]+math.Log(-x[8]+400.0),
+				-x[21]+math.Log(x[16]),
+				-x[7]-x[9]+x[12]*x[17]-x[12]*x[18]+400.0,
+				x[7]-x[8]-x[10]+x[13]*x[19]-x[13]*x[20]+400.0,
+				x[8]-x[11]-4.60517*x[14]+x[14]*x[21]+100.0,
+			)
+		}, 236.430975504001, nil))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g23",
+		append(repeat(0.0, 8), 0.01), values(300.0, 300.0, 100.0, 200.0, 100.0, 300.0, 100.0, 200.0, 0.03),
+		func(x []float64) float64 {
+			return -9.0*x[4] - 15.0*x[7] + 6.0*x[0] + 16.0*x[1] + 10.0*(x[5]+x[6])
+		}, func(x []float64) []float64 {
+			return values(x[8]*x[2]+0.02*x[5]-0.025*x[4], x[8]*x[3]+0.02*x[6]-0.015*x[7])
+		}, func(x []float64) []float64 {
+			return values(
+				x[0]+x[1]-x[2]-x[3],
+				0.03*x[0]+0.01*x[1]-x[8]*(x[2]+x[3]),
+				x[2]+x[5]-x[4],
+				x[3]+x[6]-x[7],
+			)
+		}, -400.055099999999584, values(0.00510000000000259465, 99.9947000000000514, 9.01920162996045897e-18, 99.9999000000000535,
+			0.000100000000027086086, 2.75700683389584542e-14, 99.9999999999999574, 200.0, 0.0100000100000100008)))
+
+	RegisterConstrainedProblem(NewConstrainedProblem("g24", values(0.0, 0.0), values(3.0, 4.0),
+		func(x []float64) float64 {
+			return -x[0] - x[1]
+		}, func(x []float64) []float64 {
+			x2, x3, x4 := x[0]*x[0], x[0]*x[0]*x[0], x[0]*x[0]*x[0]*x[0]
+			return values(-2.0*x4+8.0*x3-8.0*x2+x[1]-2.0, -4.0*x4+32.0*x3-88.0*x2+96.0*x[0]+x[1]-36.0)
+		}, nil, -5.50801327159536, values(2.32952019747762, 3.17849307411774)))
+}
+
+// g16Terms returns the intermediate variables y1-y17 and c1-c17 of G16, the indices start from 1
+func g16Terms(x []float64) (y, c []float64) {
+	y, c = make([]float64, 18), make([]float64, 18)
+	y[1] = x[1] + x[2] + 41.6
+	c[1] = 0.024*x[3] - 4.62
+	y[2] = 12.5/c[1] + 12.0
+	c[2] = 0.0003535*x[0]*x[0] + 0.5311*x[0] + 0.08705*y[2]*x[0]
+	c[3] = 0.052*x[0] + 78.0 + 0.002377*y[2]*x[0]
+	y[3] = c[2] / c[3]
+	y[4] = 19.0 * y[3]
+	c[4] = 0.04782*(x[0]-y[3]) + 0.1956*(x[0]-y[3])*(x[0]-y[3])/x[1] + 0.6376*y[4] + 1.594*y[3]
+	c[5] = 100.0 * x[1]
+	c[6] = x[0] - y[3] - y[4]
+	c[7] = 0.950 - c[4]/c[5]
+	y[5] = c[6] * c[7]
+	y[6] = x[0] - y[5] - y[4] - y[3]
+	c[8] = (y[5] + y[4]) * 0.995
+	y[7] = c[8] / y[1]
+	y[8] = c[8] / 3798.0
+	c[9] = y[7] - 0.0663*y[7]/y[8] - 0.3153
+	y[9] = 96.82/c[9] + 0.321*y[1]
+	y[10] = 1.29*y[5] + 1.258*y[4] + 2.29*y[3] + 1.71*y[6]
+	y[11] = 1.71*x[0] - 0.452*y[4] + 0.580*y[3]
+	c[10] = 12.3 / 752.3
+	c[11] = (1.75 * y[2]) * (0.995 * x[0])
+	c[12] = 0.995*y[10] + 1998.0
+	y[12] = c[10]*x[0] + c[11]/c[12]
+	y[13] = c[12] - 1.75*y[2]
+	y[14] = 3623.0 + 64.4*x[1] + 58.4*x[2] + 146312.0/(y[9]+x[4])
+	c[13] = 0.995*y[10] + 60.8*x[1] + 48.0*x[3] - 0.1121*y[14] - 5095.0
+	y[15] = y[13] / c[13]
+	y[16] = 148000.0 - 331000.0*y[15] + 40.0*y[13] - 61.0*y[15]*y[13]
+	c[14] = 2324.0*y[10] - 28740000.0*y[2]
+	y[17] = 14130000.0 - 1328.0*y[10] - 531.0*y[11] + c[14]/c[12]
+	c[15] = y[13]/y[15] - y[13]/0.52
+	c[16] = 1.104 - 0.72*y[15]
+	c[17] = y[9] + x[4]
+	return
+}
+
+// the data of G19
+var (
+	g19a = [][]float64{
+		{-16.0, 2.0, 0.0, 1.0, 0.0},
+		{0.0, -2.0, 0.0, 0.4, 2.0},
+		{-3.5, 0.0, 2.0, 0.0, 0.0},
+		{0.0, -2.0, 0.0, -4.0, -1.0},
+		{0.0, -9.0, -2.0, 1.0, -2.8},
+		{2.0, 0.0, -4.0, 0.0, 0.0},
+		{-1.0, -1.0, -1.0, -1.0, -1.0},
+		{-1.0, -2.0, -3.0, -2.0, -1.0},
+		{1.0, 2.0, 3.0, 4.0, 5.0},
+		{1.0, 1.0, 1.0, 1.0, 1.0},
+	}
+	g19b = []float64{-40.0, -2.0, -0.25, -4.0, -4.0, -1.0, -40.0, -60.0, 5.0, 1.0}
+	g19c = [][]float64{
+		{30.0, -20.0, -10.0, 32.0, -10.0},
+		{-20.0, 39.0, -6.0, -31.0, 32.0},
+		{-10.0, -6.0, 10.0, -6.0, -10.0},
+		{32.0, -31.0, -6.0, 39.0, -20.0},
+		{-10.0, 32.0, -10.0, -20.0, 30.0},
+	}
+	g19d = []float64{4.0, 8.0, 10.0, 6.0, 2.0}
+	g19e = []float64{-15.0, -27.0, -36.0, -18.0, -12.0}
+)
+
+// the data of G20
+var (
+	g20a = []float64{0.0693, 0.0577, 0.05, 0.2, 0.26, 0.55, 0.06, 0.1, 0.12, 0.18, 0.1, 0.09,
+		0.0693, 0.0577, 0.05, 0.2, 0.26, 0.55, 0.06, 0.1, 0.12, 0.18, 0.1, 0.09}
+	g20b = []float64{44.094, 58.12, 58.12, 137.4, 120.9, 170.9, 62.501, 84.94, 133.425, 82.507, 46.07, 60.097,
+		44.094, 58.12, 58.12, 137.4, 120.9, 170.9, 62.501, 84.94, 133.425, 82.507, 46.07, 60.097}
+	g20c = []float64{123.7, 31.7, 45.7, 14.7, 84.7, 27.7, 49.7, 7.1, 2.1, 17.7, 0.85, 0.64}
+	g20d = []float64{31.244, 36.12, 34.784, 92.7, 82.7, 91.6, 56.708, 82.7, 80.8, 64.517, 49.4, 49.1}
+	g20e = []float64{0.1, 0.3, 0.4, 0.3, 0.6, 0.3}
+)
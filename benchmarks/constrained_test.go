@@ -0,0 +1,70 @@
+package benchmarks
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/bounds"
+)
+
+func TestConstrainedOptimum(t *testing.T) {
+	names := ConstrainedProblemNames()
+	if len(names) != 24 || names[0] != "g01" || names[23] != "g24" {
+		t.Fatalf("G01-G24 should be registered: %v", names)
+	}
+	for _, name := range names {
+		cp := GetConstrainedProblem(name)
+		x := cp.GetOptimizer()
+		if x == nil {
+			continue
+		}
+		if len(x) != cp.Dims() {
+			t.Errorf("%s: the optimizer should have %d variables: %v", name, cp.Dims(), x)
+			continue
+		}
+		low, up := cp.GetLow(), cp.GetUp()
+		for i, v := range x {
+			if v < low[i]-1E-9 || v > up[i]+1E-9 {
+				t.Errorf("%s: the optimizer is out of bounds: %v", name, x)
+			}
+		}
+		// the reported optimizers are rounded, so the tolerances are relative
+		if f := cp.Objective(x); math.Abs(f-cp.GetOptimum()) > 1E-6*math.Max(1.0, math.Abs(cp.GetOptimum())) {
+			t.Errorf("%s: expected the optimum %v, got %v", name, cp.GetOptimum(), f)
+		}
+		if v := cp.Violation(x); v > 1E-10 {
+			t.Errorf("%s: the optimizer should be feasible: %v", name, cp.Violations(x))
+		}
+	}
+}
+
+func TestConstrainedProblem(t *testing.T) {
+	cp := GetConstrainedProblem("g11")
+	// h(x) = x2 - x1^2
+	if v := cp.Violations([]float64{0.0, 0.5}); len(v) != 1 || math.Abs(v[0]-(0.5-EqualityTolerance)) > 1E-12 {
+		t.Errorf("wrong violations: %v", v)
+	}
+	if v := cp.Violation([]float64{0.0, 0.5 * EqualityTolerance}); v != 0.0 {
+		t.Errorf("the equality within the tolerance should be satisfied: %v", v)
+	}
+	ind := base.NewFloat64Individual([]float64{0.5, 0.25}, base.NewFitness([]float64{-1.0}))
+	if !cp.IsFeasible(ind) || cp.Evaluate(ind)[0] != 0.8125 || len(cp.EvaluateViolations(ind)) != 1 {
+		t.Errorf("wrong evaluation of %v", ind)
+	}
+	cp = GetConstrainedProblem("g06")
+	ind = base.NewFloat64Individual([]float64{50.0, 50.0}, base.NewFitness([]float64{-1.0}))
+	if g, h := cp.Constraints(ind.GetChromosome().([]float64)); len(g) != 2 || h != nil || cp.IsFeasible(ind) {
+		t.Errorf("%v should violate g2 of g06: %v", ind, g)
+	}
+	b := cp.GetBounds(bounds.Clip)
+	if b.Len() != 2 || b.Low(0) != 13.0 || b.Up(1) != 100.0 {
+		t.Errorf("wrong bounds of g06")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("the unknown problem should panic")
+		}
+	}()
+	GetConstrainedProblem("g25")
+}
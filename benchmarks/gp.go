@@ -0,0 +1,452 @@
+package benchmarks
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/sineatos/deag/base"
+)
+
+//*************************************************************
+// Symbolic regression
+//*************************************************************
+
+// Kotanchek function [Vladislavleva2009] F1, data is [x1, x2].
+//
+// `f(\\mathbf{x}) = \\frac{e^{-(x_1 - 1)^2}}{3.2 + (x_2 - 2.5)^2}`
+//
+// [Vladislavleva2009] Vladislavleva, Smits and den Hertog, "Order of nonlinearity as a complexity measure for models generated by symbolic regression via Pareto genetic programming", 2009.
+func Kotanchek(data []float64) float64 {
+	return math.Exp(-math.Pow(data[0]-1.0, 2.0)) / (3.2 + math.Pow(data[1]-2.5, 2.0))
+}
+
+// Salustowicz1D is the one dimensional Salustowicz function [Vladislavleva2009] F2, data is [x].
+//
+// `f(x) = e^{-x} x^3 \\cos(x) \\sin(x) (\\cos(x)\\sin^2(x) - 1)`
+func Salustowicz1D(data []float64) float64 {
+	x := data[0]
+	return math.Exp(-x) * math.Pow(x, 3.0) * math.Cos(x) * math.Sin(x) * (math.Cos(x)*math.Pow(math.Sin(x), 2.0) - 1.0)
+}
+
+// Salustowicz2D is the two dimensional Salustowicz function [Vladislavleva2009] F3, data is [x1, x2].
+//
+// `f(\\mathbf{x}) = e^{-x_1} x_1^3 \\cos(x_1) \\sin(x_1) (\\cos(x_1)\\sin^2(x_1) - 1) (x_2 - 5)`
+func Salustowicz2D(data []float64) float64 {
+	return Salustowicz1D(data) * (data[1] - 5.0)
+}
+
+// UnwrappedBall is the unwrapped ball function [Vladislavleva2009] F4 of any dimensions.
+//
+// `f(\\mathbf{x}) = \\frac{10}{5 + \\sum_{i=1}^n (x_i - 3)^2}`
+func UnwrappedBall(data []float64) float64 {
+	total := 0.0
+	for _, d := range data {
+		total += math.Pow(d-3.0, 2.0)
+	}
+	return 10.0 / (5.0 + total)
+}
+
+// RationalPolynomial is the rational polynomial function [Vladislavleva2009] F5, data is [x1, x2, x3].
+//
+// `f(\\mathbf{x}) = \\frac{30 (x_1 - 1) (x_3 - 1)}{x_2^2 (x_1 - 10)}`
+func RationalPolynomial(data []float64) float64 {
+	return 30.0 * (data[0] - 1.0) * (data[2] - 1.0) / (data[1] * data[1] * (data[0] - 10.0))
+}
+
+// SinCos is the sine cosine function [Vladislavleva2009] F6, data is [x1, x2].
+//
+// `f(\\mathbf{x}) = 6\\sin(x_1)\\cos(x_2)`
+func SinCos(data []float64) float64 {
+	return 6.0 * math.Sin(data[0]) * math.Cos(data[1])
+}
+
+// Ripple function [Vladislavleva2009] F7, data is [x1, x2].
+//
+// `f(\\mathbf{x}) = (x_1 - 3) (x_2 - 3) + 2 \\sin((x_1 - 4) (x_2 - 4))`
+func Ripple(data []float64) float64 {
+	return (data[0]-3.0)*(data[1]-3.0) + 2.0*math.Sin((data[0]-4.0)*(data[1]-4.0))
+}
+
+// RationalPolynomial2 is the two dimensional rational polynomial function [Vladislavleva2009] F8, data is [x1, x2].
+//
+// `f(\\mathbf{x}) = \\frac{(x_1 - 3)^4 + (x_2 - 3)^3 - (x_2 - 3)}{(x_2 - 2)^4 + 10}`
+func RationalPolynomial2(data []float64) float64 {
+	return (math.Pow(data[0]-3.0, 4.0) + math.Pow(data[1]-3.0, 3.0) - (data[1] - 3.0)) / (math.Pow(data[1]-2.0, 4.0) + 10.0)
+}
+
+// Sampling returns the inputs of a dataset
+type Sampling func() [][]float64
+
+// UniformSampling returns the Sampling of n random points, the i-th component is uniformly sampled from [low[i], up[i]], it is U[low, up, n] in [McDermott2012].
+//
+// [McDermott2012] McDermott et al., "Genetic programming needs better benchmarks", 2012.
+func UniformSampling(low, up []float64, n int) Sampling {
+	return func() [][]float64 {
+		inputs := make([][]float64, n)
+		for i := range inputs {
+			inputs[i] = make([]float64, len(low))
+			for j := range low {
+				inputs[i][j] = low[j] + rand.Float64()*(up[j]-low[j])
+			}
+		}
+		return inputs
+	}
+}
+
+// EvenlySpacedSampling returns the Sampling of the grid whose i-th component goes from low[i] to up[i] (inclusive) with step[i], it is E[low, up, step] in [McDermott2012].
+func EvenlySpacedSampling(low, up, step []float64) Sampling {
+	return func() [][]float64 {
+		axes := make([][]float64, len(low))
+		for j := range low {
+			n := int(math.Floor((up[j]-low[j])/step[j]+1E-9)) + 1
+			axes[j] = make([]float64, n)
+			for k := range axes[j] {
+				axes[j][k] = low[j] + float64(k)*step[j]
+			}
+		}
+		inputs := [][]float64{{}}
+		for _, axis := range axes {
+			next := make([][]float64, 0, len(inputs)*len(axis))
+			for _, input := range inputs {
+				for _, v := range axis {
+					point := make([]float64, len(input), len(input)+1)
+					copy(point, input)
+					next = append(next, append(point, v))
+				}
+			}
+			inputs = next
+		}
+		return inputs
+	}
+}
+
+// Dataset saves the inputs and the outputs of a symbolic regression problem
+type Dataset struct {
+	inputs  [][]float64
+	outputs []float64
+}
+
+// NewDataset returns *Dataset whose outputs are computed by target
+func NewDataset(target func([]float64) float64, inputs [][]float64) *Dataset {
+	outputs := make([]float64, len(inputs))
+	for i, input := range inputs {
+		outputs[i] = target(input)
+	}
+	return &Dataset{inputs: inputs, outputs: outputs}
+}
+
+// Len returns the number of cases
+func (ds *Dataset) Len() int {
+	return len(ds.inputs)
+}
+
+// GetInputs returns the inputs (not a copy)
+func (ds *Dataset) GetInputs() [][]float64 {
+	return ds.inputs
+}
+
+// GetOutputs returns the outputs (not a copy)
+func (ds *Dataset) GetOutputs() []float64 {
+	return ds.outputs
+}
+
+// MSE returns the mean squared error of model on the dataset
+func (ds *Dataset) MSE(model func([]float64) float64) float64 {
+	total := 0.0
+	for i, input := range ds.inputs {
+		diff := model(input) - ds.outputs[i]
+		total += diff * diff
+	}
+	return total / float64(len(ds.inputs))
+}
+
+// SymbolicRegression describes a symbolic regression benchmark with its training and testing sampling
+type SymbolicRegression struct {
+	name   string
+	dims   int
+	target func([]float64) float64
+	train  Sampling
+	test   Sampling
+}
+
+// NewSymbolicRegression returns *SymbolicRegression, dims is the number of input variables
+func NewSymbolicRegression(name string, dims int, target func([]float64) float64, train, test Sampling) *SymbolicRegression {
+	return &SymbolicRegression{name: name, dims: dims, target: target, train: train, test: test}
+}
+
+// GetName returns the name of the benchmark
+func (sr *SymbolicRegression) GetName() string {
+	return sr.name
+}
+
+// Dims returns the number of input variables
+func (sr *SymbolicRegression) Dims() int {
+	return sr.dims
+}
+
+// Target returns the value of the target function
+func (sr *SymbolicRegression) Target(data []float64) float64 {
+	return sr.target(data)
+}
+
+// TrainingSet returns a new training set, the uniformly sampled points are different in each call
+func (sr *SymbolicRegression) TrainingSet() *Dataset {
+	return NewDataset(sr.target, sr.train())
+}
+
+// TestingSet returns a new testing set, the uniformly sampled points are different in each call
+func (sr *SymbolicRegression) TestingSet() *Dataset {
+	return NewDataset(sr.target, sr.test())
+}
+
+// Benchmark returns the *Benchmark of the target function of Dims() variables, which is minimized as the other single-objective functions
+func (sr *SymbolicRegression) Benchmark() *Benchmark {
+	return NewBenchmark(sr.name, Float64Evaluator(func(individual *base.Float64Individual) []float64 {
+		return []float64{sr.target(individual.GetChromosome().([]float64))}
+	}), 1, false).SetDims(sr.dims, sr.dims, nil)
+}
+
+var symbolicRegressions = make(map[string]*SymbolicRegression)
+
+// RegisterSymbolicRegression registers sr by its name, which is used by GetSymbolicRegression,
+// and registers sr.Benchmark() by the same name, which is used by GetBenchmark
+func RegisterSymbolicRegression(sr *SymbolicRegression) {
+	symbolicRegressions[sr.name] = sr
+	RegisterBenchmark(sr.Benchmark())
+}
+
+// GetSymbolicRegression returns the registered SymbolicRegression named name, such as "kotanchek", "keijzer-6" and "nguyen-7", see SymbolicRegressionNames
+func GetSymbolicRegression(name string) *SymbolicRegression {
+	sr, ok := symbolicRegressions[name]
+	if !ok {
+		panic(fmt.Sprintf("Unknown symbolic regression benchmark: %s", name))
+	}
+	return sr
+}
+
+// SymbolicRegressionNames returns the sorted names of the registered symbolic regression benchmarks
+func SymbolicRegressionNames() []string {
+	names := make([]string, 0, len(symbolicRegressions))
+	for name := range symbolicRegressions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// repeat returns a slice of n values
+func repeat(value float64, n int) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = value
+	}
+	return values
+}
+
+// uniform1D returns U[low, up, n] of one variable
+func uniform1D(low, up float64, n int) Sampling {
+	return UniformSampling([]float64{low}, []float64{up}, n)
+}
+
+// even1D returns E[low, up, step] of one variable
+func even1D(low, up, step float64) Sampling {
+	return EvenlySpacedSampling([]float64{low}, []float64{up}, []float64{step})
+}
+
+// uniform2D returns U[low, up, n] of two variables
+func uniform2D(low, up float64, n int) Sampling {
+	return UniformSampling(repeat(low, 2), repeat(up, 2), n)
+}
+
+// even2D returns E[low, up, step] of two variables
+func even2D(low, up, step float64) Sampling {
+	return EvenlySpacedSampling(repeat(low, 2), repeat(up, 2), repeat(step, 2))
+}
+
+// The samplings follow [Vladislavleva2009] and [McDermott2012].
+func init() {
+	// Vladislavleva
+	RegisterSymbolicRegression(NewSymbolicRegression("kotanchek", 2, Kotanchek, uniform2D(0.3, 4.0, 100), even2D(-0.2, 4.2, 0.1)))
+	RegisterSymbolicRegression(NewSymbolicRegression("salustowicz-1d", 1, Salustowicz1D, even1D(0.05, 10.0, 0.1), even1D(-0.5, 10.5, 0.05)))
+	RegisterSymbolicRegression(NewSymbolicRegression("salustowicz-2d", 2, Salustowicz2D,
+		EvenlySpacedSampling([]float64{0.05, 0.05}, []float64{10.0, 10.05}, []float64{0.1, 2.0}),
+		EvenlySpacedSampling([]float64{-0.5, -0.5}, []float64{10.5, 10.5}, []float64{0.05, 0.5})))
+	RegisterSymbolicRegression(NewSymbolicRegression("unwrapped-ball", 5, UnwrappedBall,
+		UniformSampling(repeat(0.05, 5), repeat(6.05, 5), 1024), UniformSampling(repeat(-0.25, 5), repeat(6.35, 5), 5000)))
+	RegisterSymbolicRegression(NewSymbolicRegression("rational-polynomial", 3, RationalPolynomial,
+		UniformSampling([]float64{0.05, 1.0, 0.05}, []float64{2.0, 2.0, 2.0}, 300),
+		EvenlySpacedSampling([]float64{-0.05, 0.95, -0.05}, []float64{2.1, 2.05, 2.1}, []float64{0.15, 0.1, 0.15})))
+	RegisterSymbolicRegression(NewSymbolicRegression("sin-cos", 2, SinCos, even2D(0.1, 5.9, 0.2), even2D(-0.05, 6.05, 0.02)))
+	RegisterSymbolicRegression(NewSymbolicRegression("ripple", 2, Ripple, uniform2D(0.05, 6.05, 300), even2D(-0.25, 6.35, 0.2)))
+	RegisterSymbolicRegression(NewSymbolicRegression("rational-polynomial-2", 2, RationalPolynomial2, uniform2D(0.05, 6.05, 50), even2D(-0.25, 6.35, 0.2)))
+
+	// Keijzer
+	keijzer1 := func(data []float64) float64 { return 0.3 * data[0] * math.Sin(2.0*math.Pi*data[0]) }
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-1", 1, keijzer1, even1D(-1.0, 1.0, 0.1), even1D(-1.0, 1.0, 0.001)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-2", 1, keijzer1, even1D(-2.0, 2.0, 0.1), even1D(-2.0, 2.0, 0.001)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-3", 1, keijzer1, even1D(-3.0, 3.0, 0.1), even1D(-3.0, 3.0, 0.001)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-4", 1, Salustowicz1D, even1D(0.0, 10.0, 0.05), even1D(0.05, 10.05, 0.05)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-5", 3, func(data []float64) float64 {
+		return 30.0 * data[0] * data[2] / ((data[0] - 10.0) * data[1] * data[1])
+	}, UniformSampling([]float64{-1.0, 1.0, -1.0}, []float64{1.0, 2.0, 1.0}, 1000), UniformSampling([]float64{-1.0, 1.0, -1.0}, []float64{1.0, 2.0, 1.0}, 10000)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-6", 1, func(data []float64) float64 {
+		total := 0.0
+		for i := 1; i <= int(data[0]); i++ {
+			total += 1.0 / float64(i)
+		}
+		return total
+	}, even1D(1.0, 50.0, 1.0), even1D(1.0, 120.0, 1.0)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-7", 1, func(data []float64) float64 {
+		return math.Log(data[0])
+	}, even1D(1.0, 100.0, 1.0), even1D(1.0, 100.0, 0.1)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-8", 1, func(data []float64) float64 {
+		return math.Sqrt(data[0])
+	}, even1D(0.0, 100.0, 1.0), even1D(0.0, 100.0, 0.1)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-9", 1, func(data []float64) float64 {
+		return math.Asinh(data[0])
+	}, even1D(0.0, 100.0, 1.0), even1D(0.0, 100.0, 0.1)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-10", 2, func(data []float64) float64 {
+		return math.Pow(data[0], data[1])
+	}, uniform2D(0.0, 1.0, 100), even2D(0.0, 1.0, 0.01)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-11", 2, func(data []float64) float64 {
+		return data[0]*data[1] + math.Sin((data[0]-1.0)*(data[1]-1.0))
+	}, uniform2D(-3.0, 3.0, 20), even2D(-3.0, 3.0, 0.01)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-12", 2, func(data []float64) float64 {
+		return math.Pow(data[0], 4.0) - math.Pow(data[0], 3.0) + data[1]*data[1]/2.0 - data[1]
+	}, uniform2D(-3.0, 3.0, 20), even2D(-3.0, 3.0, 0.01)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-13", 2, SinCos, uniform2D(-3.0, 3.0, 20), even2D(-3.0, 3.0, 0.01)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-14", 2, func(data []float64) float64 {
+		return 8.0 / (2.0 + data[0]*data[0] + data[1]*data[1])
+	}, uniform2D(-3.0, 3.0, 20), even2D(-3.0, 3.0, 0.01)))
+	RegisterSymbolicRegression(NewSymbolicRegression("keijzer-15", 2, func(data []float64) float64 {
+		return math.Pow(data[0], 3.0)/5.0 + math.Pow(data[1], 3.0)/2.0 - data[1] - data[0]
+	}, uniform2D(-3.0, 3.0, 20), even2D(-3.0, 3.0, 0.01)))
+
+	// Nguyen, the testing sets are sampled in the same way as the training sets
+	polynomial := func(degree int) func([]float64) float64 {
+		return func(data []float64) float64 {
+			total, p := 0.0, 1.0
+			for i := 0; i < degree; i++ {
+				p *= data[0]
+				total += p
+			}
+			return total
+		}
+	}
+	for degree := 3; degree <= 6; degree++ {
+		name := fmt.Sprintf("nguyen-%d", degree-2)
+		RegisterSymbolicRegression(NewSymbolicRegression(name, 1, polynomial(degree), uniform1D(-1.0, 1.0, 20), uniform1D(-1.0, 1.0, 20)))
+	}
+	RegisterSymbolicRegression(NewSymbolicRegression("nguyen-5", 1, func(data []float64) float64 {
+		return math.Sin(data[0]*data[0])*math.Cos(data[0]) - 1.0
+	}, uniform1D(-1.0, 1.0, 20), uniform1D(-1.0, 1.0, 20)))
+	RegisterSymbolicRegression(NewSymbolicRegression("nguyen-6", 1, func(data []float64) float64 {
+		return math.Sin(data[0]) + math.Sin(data[0]+data[0]*data[0])
+	}, uniform1D(-1.0, 1.0, 20), uniform1D(-1.0, 1.0, 20)))
+	RegisterSymbolicRegression(NewSymbolicRegression("nguyen-7", 1, func(data []float64) float64 {
+		return math.Log(data[0]+1.0) + math.Log(data[0]*data[0]+1.0)
+	}, uniform1D(0.0, 2.0, 20), uniform1D(0.0, 2.0, 20)))
+	RegisterSymbolicRegression(NewSymbolicRegression("nguyen-8", 1, func(data []float64) float64 {
+		return math.Sqrt(data[0])
+	}, uniform1D(0.0, 4.0, 20), uniform1D(0.0, 4.0, 20)))
+	RegisterSymbolicRegression(NewSymbolicRegression("nguyen-9", 2, func(data []float64) float64 {
+		return math.Sin(data[0]) + math.Sin(data[1]*data[1])
+	}, uniform2D(-1.0, 1.0, 100), uniform2D(-1.0, 1.0, 100)))
+	RegisterSymbolicRegression(NewSymbolicRegression("nguyen-10", 2, func(data []float64) float64 {
+		return 2.0 * math.Sin(data[0]) * math.Cos(data[1])
+	}, uniform2D(-1.0, 1.0, 100), uniform2D(-1.0, 1.0, 100)))
+	RegisterSymbolicRegression(NewSymbolicRegression("nguyen-11", 2, func(data []float64) float64 {
+		return math.Pow(data[0], data[1])
+	}, uniform2D(0.0, 1.0, 100), uniform2D(0.0, 1.0, 100)))
+	RegisterSymbolicRegression(NewSymbolicRegression("nguyen-12", 2, func(data []float64) float64 {
+		return math.Pow(data[0], 4.0) - math.Pow(data[0], 3.0) + data[1]*data[1]/2.0 - data[1]
+	}, uniform2D(-1.0, 1.0, 100), uniform2D(-1.0, 1.0, 100)))
+}
+
+//*************************************************************
+// Boolean problems
+//*************************************************************
+
+// Parity returns the even parity of bits, it is true if the number of true bits is even
+func Parity(bits []bool) bool {
+	parity := true
+	for _, b := range bits {
+		if b {
+			parity = !parity
+		}
+	}
+	return parity
+}
+
+// Multiplexer returns the data bit addressed by the first selectLines bits, bits has selectLines + 2^selectLines elements.
+// The address bits are little endian, the output is bits[selectLines + address].
+func Multiplexer(bits []bool, selectLines int) bool {
+	address := 0
+	for j, b := range bits[:selectLines] {
+		if b {
+			address += 1 << uint(j)
+		}
+	}
+	return bits[selectLines+address]
+}
+
+// BoolDataset saves all the cases of a boolean problem
+type BoolDataset struct {
+	inputs  [][]bool
+	outputs []bool
+}
+
+// NewBoolDataset returns *BoolDataset containing all the 2^fanIn cases, the outputs are computed by target.
+// The i-th case is the binary representation of i whose most significant bit is the first input.
+func NewBoolDataset(fanIn int, target func([]bool) bool) *BoolDataset {
+	size := 1 << uint(fanIn)
+	inputs, outputs := make([][]bool, size), make([]bool, size)
+	for i := range inputs {
+		inputs[i] = make([]bool, fanIn)
+		for j := range inputs[i] {
+			inputs[i][j] = i&(1<<uint(fanIn-1-j)) != 0
+		}
+		outputs[i] = target(inputs[i])
+	}
+	return &BoolDataset{inputs: inputs, outputs: outputs}
+}
+
+// NewParityDataset returns the dataset of even parity problem with fanIn inputs, the classical problem uses fanIn=6
+func NewParityDataset(fanIn int) *BoolDataset {
+	return NewBoolDataset(fanIn, Parity)
+}
+
+// NewMultiplexerDataset returns the dataset of multiplexer problem with selectLines address bits, the classical 11-multiplexer uses selectLines=3
+func NewMultiplexerDataset(selectLines int) *BoolDataset {
+	return NewBoolDataset(selectLines+(1<<uint(selectLines)), func(bits []bool) bool {
+		return Multiplexer(bits, selectLines)
+	})
+}
+
+// Len returns the number of cases
+func (ds *BoolDataset) Len() int {
+	return len(ds.inputs)
+}
+
+// GetInputs returns the inputs (not a copy)
+func (ds *BoolDataset) GetInputs() [][]bool {
+	return ds.inputs
+}
+
+// GetOutputs returns the outputs (not a copy)
+func (ds *BoolDataset) GetOutputs() []bool {
+	return ds.outputs
+}
+
+// Hits returns the number of cases where model gives the right output
+func (ds *BoolDataset) Hits(model func([]bool) bool) int {
+	hits := 0
+	for i, input := range ds.inputs {
+		if model(input) == ds.outputs[i] {
+			hits++
+		}
+	}
+	return hits
+}
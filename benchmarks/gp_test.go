@@ -0,0 +1,67 @@
+package benchmarks
+
+import (
+	"testing"
+)
+
+func TestSymbolicRegression(t *testing.T) {
+	sizes := map[string]int{
+		"kotanchek":      100,
+		"salustowicz-1d": 100,
+		"keijzer-1":      21,
+		"keijzer-6":      50,
+		"keijzer-11":     20,
+		"nguyen-1":       20,
+		"nguyen-9":       100,
+	}
+	for name, size := range sizes {
+		sr := GetSymbolicRegression(name)
+		train := sr.TrainingSet()
+		if train.Len() != size {
+			t.Errorf("%s: the size of training set should be %d: %d", name, size, train.Len())
+		}
+		for _, input := range train.GetInputs() {
+			if len(input) != sr.Dims() {
+				t.Errorf("%s: the dimension of input should be %d: %v", name, sr.Dims(), input)
+			}
+		}
+		if mse := train.MSE(sr.Target); mse != 0.0 {
+			t.Errorf("%s: the mse of the target should be 0: %v", name, mse)
+		}
+	}
+	if n := len(SymbolicRegressionNames()); n != 8+15+12 {
+		t.Errorf("the number of symbolic regression benchmarks should be 35: %v", n)
+	}
+	// keijzer-6 is the harmonic number
+	checkValue(t, 1.0+1.0/2.0+1.0/3.0, GetSymbolicRegression("keijzer-6").Target([]float64{3.0}), 1)
+	checkValue(t, 4.0, GetSymbolicRegression("nguyen-1").Target([]float64{-2.0})+10.0, 1)
+	grid := EvenlySpacedSampling([]float64{0.0, 1.0}, []float64{1.0, 2.0}, []float64{0.5, 1.0})()
+	if len(grid) != 6 || grid[5][0] != 1.0 || grid[5][1] != 2.0 {
+		t.Errorf("wrong grid: %v", grid)
+	}
+}
+
+func TestBooleanProblems(t *testing.T) {
+	parity := NewParityDataset(6)
+	if parity.Len() != 64 {
+		t.Errorf("the size of 6-parity dataset should be 64: %v", parity.Len())
+	}
+	if !parity.GetOutputs()[0] || parity.GetOutputs()[1] || !parity.GetOutputs()[3] {
+		t.Errorf("wrong even parity: %v", parity.GetOutputs()[:4])
+	}
+	if hits := parity.Hits(Parity); hits != 64 {
+		t.Errorf("Parity should hit all the cases: %v", hits)
+	}
+	mux := NewMultiplexerDataset(3)
+	if mux.Len() != 2048 {
+		t.Errorf("the size of 11-multiplexer dataset should be 2048: %v", mux.Len())
+	}
+	// the address bits 1, 1, 0 (little endian) give 3, which selects bits[3+3]
+	bits := []bool{true, true, false, false, false, false, true, false, false, false, false}
+	if !Multiplexer(bits, 3) {
+		t.Errorf("wrong multiplexer output: %v", bits)
+	}
+	if hits := mux.Hits(func([]bool) bool { return false }); hits != 1024 {
+		t.Errorf("a constant model should hit half of the cases: %v", hits)
+	}
+}
@@ -0,0 +1,192 @@
+package benchmarks
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/sineatos/deag/base"
+)
+
+// The correlations between the weights and the profits of GenerateCorrelatedKnapsack
+const (
+	// KnapsackUncorrelated generates the profits independently of the weights
+	KnapsackUncorrelated = iota
+	// KnapsackWeaklyCorrelated generates the profits in [w - R/10, w + R/10] for the weight w
+	KnapsackWeaklyCorrelated
+	// KnapsackStronglyCorrelated generates the profit w + R/10 for the weight w
+	KnapsackStronglyCorrelated
+)
+
+// Knapsack is a 0/1 knapsack problem with one or more knapsacks. An item is packed into all knapsacks if its bit is true,
+// the profit of each knapsack is an objective to be maximized and the weight in each knapsack must not exceed its capacity.
+//
+// The problem with m knapsacks is the multi-objective 0/1 knapsack problem from: E. Zitzler and L. Thiele. Multiobjective Evolutionary Algorithms: A Comparative Case Study and the Strength Pareto Approach. IEEE Transactions on Evolutionary Computation, vol. 3, no. 4, p. 257 - 271, 1999.
+type Knapsack struct {
+	weights    [][]float64
+	profits    [][]float64
+	capacities []float64
+	order      []int // the items in the order of removal by Repair
+}
+
+// NewKnapsack returns *Knapsack of len(capacities) knapsacks, weights[i][j] and profits[i][j] are the weight and the profit of the item j in the knapsack i
+func NewKnapsack(weights, profits [][]float64, capacities []float64) *Knapsack {
+	if len(capacities) == 0 || len(weights) != len(capacities) || len(profits) != len(capacities) {
+		panic(fmt.Sprintf("The weights and the profits should be given for %d knapsacks", len(capacities)))
+	}
+	items := len(weights[0])
+	for i := range capacities {
+		if len(weights[i]) != items || len(profits[i]) != items {
+			panic(fmt.Sprintf("The weights and the profits of the knapsack %d should be given for %d items", i, items))
+		}
+	}
+	ks := &Knapsack{weights: weights, profits: profits, capacities: capacities, order: make([]int, items)}
+	// the items with the lower maximum profit/weight ratio are removed earlier
+	ratios := make([]float64, items)
+	for j := range ks.order {
+		ks.order[j] = j
+		ratios[j] = math.Inf(-1)
+		for i := range capacities {
+			ratios[j] = math.Max(ratios[j], profits[i][j]/weights[i][j])
+		}
+	}
+	sort.SliceStable(ks.order, func(a, b int) bool {
+		return ratios[ks.order[a]] < ratios[ks.order[b]]
+	})
+	return ks
+}
+
+// NewSingleKnapsack returns *Knapsack of one knapsack
+func NewSingleKnapsack(weights, profits []float64, capacity float64) *Knapsack {
+	return NewKnapsack([][]float64{weights}, [][]float64{profits}, []float64{capacity})
+}
+
+// GenerateKnapsack returns a random *Knapsack of Zitzler and Thiele, whose weights and profits are random integers in [10, 100] and the capacity of each knapsack is half of its total weight
+func GenerateKnapsack(items, knapsacks int) *Knapsack {
+	weights, profits, capacities := make([][]float64, knapsacks), make([][]float64, knapsacks), make([]float64, knapsacks)
+	for i := range capacities {
+		weights[i], profits[i] = make([]float64, items), make([]float64, items)
+		for j := 0; j < items; j++ {
+			weights[i][j] = float64(10 + rand.Intn(91))
+			profits[i][j] = float64(10 + rand.Intn(91))
+			capacities[i] += weights[i][j]
+		}
+		capacities[i] /= 2.0
+	}
+	return NewKnapsack(weights, profits, capacities)
+}
+
+// GenerateCorrelatedKnapsack returns a random single *Knapsack of Pisinger, whose weights are random integers in [1, R] and the profits are correlated with the weights by correlation.
+// The capacity is half of the total weight.
+//
+// From: D. Pisinger. Core Problems in Knapsack Algorithms. Operations Research, vol. 47, no. 4, p. 570 - 575, 1999.
+func GenerateCorrelatedKnapsack(items, correlation int, R float64) *Knapsack {
+	weights, profits, capacity := make([]float64, items), make([]float64, items), 0.0
+	for j := range weights {
+		weights[j] = float64(1 + rand.Intn(int(R)))
+		switch correlation {
+		case KnapsackUncorrelated:
+			profits[j] = float64(1 + rand.Intn(int(R)))
+		case KnapsackWeaklyCorrelated:
+			low := math.Max(1.0, weights[j]-math.Floor(R/10.0))
+			profits[j] = low + float64(rand.Intn(int(weights[j]+math.Floor(R/10.0)-low)+1))
+		case KnapsackStronglyCorrelated:
+			profits[j] = weights[j] + math.Floor(R/10.0)
+		default:
+			panic(fmt.Sprintf("Unknown correlation of knapsack: %v", correlation))
+		}
+		capacity += weights[j]
+	}
+	return NewSingleKnapsack(weights, profits, capacity/2.0)
+}
+
+// Len returns the number of items
+func (ks *Knapsack) Len() int {
+	return len(ks.weights[0])
+}
+
+// Knapsacks returns the number of knapsacks, which is the number of objectives
+func (ks *Knapsack) Knapsacks() int {
+	return len(ks.capacities)
+}
+
+// GetCapacities returns the capacities of the knapsacks
+func (ks *Knapsack) GetCapacities() []float64 {
+	ans := make([]float64, len(ks.capacities))
+	copy(ans, ks.capacities)
+	return ans
+}
+
+// Weights returns the total weights of the packed items x in each knapsack
+func (ks *Knapsack) Weights(x []bool) []float64 {
+	return ks.sum(ks.weights, x)
+}
+
+// Profits returns the total profits of the packed items x in each knapsack
+func (ks *Knapsack) Profits(x []bool) []float64 {
+	return ks.sum(ks.profits, x)
+}
+
+// Violations returns the overweights max(0, weight - capacity) of the knapsacks
+func (ks *Knapsack) Violations(x []bool) []float64 {
+	v := ks.Weights(x)
+	for i, c := range ks.capacities {
+		v[i] = math.Max(0.0, v[i]-c)
+	}
+	return v
+}
+
+// IsFeasible returns if no knapsack is overweight
+func (ks *Knapsack) IsFeasible(x []bool) bool {
+	for _, v := range ks.Violations(x) {
+		if v > 0.0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate is the BoolEvaluator of the knapsack, it returns the profits of individual to be maximized.
+// The infeasible individual should be repaired by Repair or penalized by Violations.
+func (ks *Knapsack) Evaluate(individual *base.BoolIndividual) []float64 {
+	return ks.Profits(individual.GetChromosome().([]bool))
+}
+
+// Repair removes the packed items of individual in place until no knapsack is overweight, the items with the lower maximum profit/weight ratio over the knapsacks are removed earlier
+func (ks *Knapsack) Repair(individual *base.BoolIndividual) *base.BoolIndividual {
+	x := individual.GetChromosome().([]bool)
+	weights := ks.Weights(x)
+	overweight := func() bool {
+		for i, w := range weights {
+			if w > ks.capacities[i] {
+				return true
+			}
+		}
+		return false
+	}
+	for _, j := range ks.order {
+		if !overweight() {
+			break
+		}
+		if x[j] {
+			x[j] = false
+			for i := range weights {
+				weights[i] -= ks.weights[i][j]
+			}
+		}
+	}
+	return individual
+}
+
+func (ks *Knapsack) sum(values [][]float64, x []bool) []float64 {
+	ans := make([]float64, len(values))
+	for i, v := range values {
+		for j, packed := range x {
+			if packed {
+				ans[i] += v[j]
+			}
+		}
+	}
+	return ans
+}
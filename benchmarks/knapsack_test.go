@@ -0,0 +1,63 @@
+package benchmarks
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/inits"
+)
+
+func TestKnapsack(t *testing.T) {
+	ks := NewSingleKnapsack([]float64{5.0, 4.0, 6.0, 3.0}, []float64{10.0, 40.0, 30.0, 50.0}, 10.0)
+	all := base.NewBoolIndividual([]bool{true, true, true, true}, base.NewFitness([]float64{1.0}))
+	if v := ks.Violations(all.GetChromosome().([]bool)); len(v) != 1 || v[0] != 8.0 || ks.IsFeasible(all.GetChromosome().([]bool)) {
+		t.Errorf("wrong violations of %v: %v", all, v)
+	}
+	// the items 0 and 2 have the lowest profit/weight ratios
+	ks.Repair(all)
+	x := all.GetChromosome().([]bool)
+	if x[0] || x[2] || !x[1] || !x[3] || !ks.IsFeasible(x) {
+		t.Errorf("wrong repaired individual: %v", all)
+	}
+	if v := ks.Evaluate(all); v[0] != 90.0 {
+		t.Errorf("Expected the profit 90, got %v", v)
+	}
+
+	ks = GenerateKnapsack(100, 3)
+	if ks.Len() != 100 || ks.Knapsacks() != 3 {
+		t.Errorf("wrong sizes of the knapsack: %v, %v", ks.Len(), ks.Knapsacks())
+	}
+	for k := 0; k < 10; k++ {
+		ind := base.NewBoolIndividual(genBools(100), base.NewFitness([]float64{1.0, 1.0, 1.0}))
+		x := ind.GetChromosome().([]bool)
+		before := ks.Profits(x)
+		ks.Repair(ind)
+		after := ks.Evaluate(ind)
+		if !ks.IsFeasible(x) || len(after) != 3 {
+			t.Errorf("the repaired individual should be feasible: %v", ks.Violations(x))
+		}
+		for i := range after {
+			if after[i] > before[i] {
+				t.Errorf("the repair shouldn't increase the profits: %v, %v", before, after)
+			}
+		}
+	}
+
+	for _, correlation := range []int{KnapsackUncorrelated, KnapsackWeaklyCorrelated, KnapsackStronglyCorrelated} {
+		ks = GenerateCorrelatedKnapsack(50, correlation, 1000.0)
+		for j := 0; j < ks.Len(); j++ {
+			w, p := ks.weights[0][j], ks.profits[0][j]
+			if w < 1.0 || w > 1000.0 || p < 1.0 ||
+				(correlation == KnapsackWeaklyCorrelated && (p < w-100.0 || p > w+100.0)) ||
+				(correlation == KnapsackStronglyCorrelated && p != w+100.0) {
+				t.Errorf("wrong item of correlation %v: weight %v, profit %v", correlation, w, p)
+			}
+		}
+	}
+}
+
+// genBools returns n random bits
+func genBools(n int) []bool {
+	return inits.GenerateBoolSliceRepeat(func() bool { return rand.Float64() < 0.5 }, n)
+}
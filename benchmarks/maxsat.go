@@ -0,0 +1,193 @@
+package benchmarks
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/sineatos/deag/base"
+)
+
+// MaxSAT is a weighted maximum satisfiability problem of a CNF formula, the total weight of the satisfied clauses should be maximized.
+// The variables are indexed from 1 as DIMACS, a literal v means the variable v is true and -v means it is false.
+// The bit i of an individual is the value of the variable i+1.
+type MaxSAT struct {
+	variables int
+	clauses   [][]int
+	weights   []float64
+}
+
+// NewMaxSAT returns *MaxSAT of the clauses over the variables, the weights of the clauses are 1 if weights is nil
+func NewMaxSAT(variables int, clauses [][]int, weights []float64) *MaxSAT {
+	if weights == nil {
+		weights = repeat(1.0, len(clauses))
+	}
+	if len(weights) != len(clauses) {
+		panic(fmt.Sprintf("The weights should be given for %d clauses: %v", len(clauses), len(weights)))
+	}
+	for _, clause := range clauses {
+		for _, literal := range clause {
+			if literal == 0 || literal > variables || -literal > variables {
+				panic(fmt.Sprintf("Invalid literal %d for %d variables", literal, variables))
+			}
+		}
+	}
+	return &MaxSAT{variables: variables, clauses: clauses, weights: weights}
+}
+
+// GenerateMaxSAT returns *MaxSAT of the uniform random k-SAT formula, each clause has k literals of the distinct variables with random signs
+func GenerateMaxSAT(variables, clauses, k int) *MaxSAT {
+	formula := make([][]int, clauses)
+	for c := range formula {
+		formula[c] = make([]int, k)
+		for i, v := range rand.Perm(variables)[:k] {
+			formula[c][i] = v + 1
+			if rand.Float64() < 0.5 {
+				formula[c][i] = -formula[c][i]
+			}
+		}
+	}
+	return NewMaxSAT(variables, formula, nil)
+}
+
+// LoadDIMACS returns *MaxSAT parsed from the DIMACS CNF file of path
+func LoadDIMACS(path string) (*MaxSAT, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ParseDIMACS(file)
+}
+
+// ParseDIMACS returns *MaxSAT parsed from the DIMACS text read from r.
+//
+// The problem line is "p cnf variables clauses" or "p wcnf variables clauses [top]", the first number of each clause of wcnf is its weight.
+// The lines starting with c are comments, the clauses are terminated by 0 and the text after a line of % is ignored as the files of SATLIB.
+func ParseDIMACS(r io.Reader) (*MaxSAT, error) {
+	variables, expected, weighted := -1, 0, false
+	var clauses [][]int
+	var weights []float64
+	var clause []int
+	weight := -1.0
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] == "c" {
+			continue
+		}
+		if fields[0] == "%" {
+			break
+		}
+		if fields[0] == "p" {
+			if len(fields) < 4 || (fields[1] != "cnf" && fields[1] != "wcnf") {
+				return nil, fmt.Errorf("line %d: invalid problem line: %s", line, scanner.Text())
+			}
+			v, err1 := strconv.Atoi(fields[2])
+			c, err2 := strconv.Atoi(fields[3])
+			if err1 != nil || err2 != nil || v < 0 || c < 0 {
+				return nil, fmt.Errorf("line %d: invalid problem line: %s", line, scanner.Text())
+			}
+			variables, expected, weighted = v, c, fields[1] == "wcnf"
+			continue
+		}
+		if variables < 0 {
+			return nil, fmt.Errorf("line %d: the clause is before the problem line", line)
+		}
+		for _, field := range fields {
+			if weighted && weight < 0.0 {
+				w, err := strconv.ParseFloat(field, 64)
+				if err != nil || w < 0.0 {
+					return nil, fmt.Errorf("line %d: invalid weight: %s", line, field)
+				}
+				weight = w
+				continue
+			}
+			literal, err := strconv.Atoi(field)
+			if err != nil || literal > variables || -literal > variables {
+				return nil, fmt.Errorf("line %d: invalid literal: %s", line, field)
+			}
+			if literal != 0 {
+				clause = append(clause, literal)
+				continue
+			}
+			if !weighted {
+				weight = 1.0
+			}
+			clauses, weights = append(clauses, clause), append(weights, weight)
+			clause, weight = nil, -1.0
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if variables < 0 {
+		return nil, fmt.Errorf("there is no problem line")
+	}
+	if len(clause) > 0 {
+		return nil, fmt.Errorf("the last clause isn't terminated by 0")
+	}
+	if len(clauses) != expected {
+		return nil, fmt.Errorf("expected %d clauses, got %d", expected, len(clauses))
+	}
+	return NewMaxSAT(variables, clauses, weights), nil
+}
+
+// Len returns the number of variables
+func (ms *MaxSAT) Len() int {
+	return ms.variables
+}
+
+// NumClauses returns the number of clauses
+func (ms *MaxSAT) NumClauses() int {
+	return len(ms.clauses)
+}
+
+// TotalWeight returns the total weight of the clauses, which is the value when all clauses are satisfied
+func (ms *MaxSAT) TotalWeight() float64 {
+	total := 0.0
+	for _, w := range ms.weights {
+		total += w
+	}
+	return total
+}
+
+// Satisfied returns the total weight of the clauses satisfied by the assignment x
+func (ms *MaxSAT) Satisfied(x []bool) float64 {
+	total := 0.0
+	for c, clause := range ms.clauses {
+		if satisfies(x, clause) {
+			total += ms.weights[c]
+		}
+	}
+	return total
+}
+
+// IsSatisfied returns if the assignment x satisfies all clauses
+func (ms *MaxSAT) IsSatisfied(x []bool) bool {
+	for _, clause := range ms.clauses {
+		if !satisfies(x, clause) {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate is the BoolEvaluator of the problem, it returns the total weight of the satisfied clauses to be maximized
+func (ms *MaxSAT) Evaluate(individual *base.BoolIndividual) []float64 {
+	return []float64{ms.Satisfied(individual.GetChromosome().([]bool))}
+}
+
+// satisfies returns if the assignment x satisfies clause
+func satisfies(x []bool, clause []int) bool {
+	for _, literal := range clause {
+		if literal > 0 && x[literal-1] || literal < 0 && !x[-literal-1] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package benchmarks
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+)
+
+func TestMaxSAT(t *testing.T) {
+	cnf := "c a formula of SATLIB\nc\np cnf 3 4\n 1 -2 0\n2 3\n 0\n-1 -3 0\n-1 0\n%\n0\n"
+	ms, err := ParseDIMACS(strings.NewReader(cnf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ms.Len() != 3 || ms.NumClauses() != 4 || ms.TotalWeight() != 4.0 {
+		t.Errorf("wrong formula: %v", ms.clauses)
+	}
+	x := []bool{false, false, true}
+	if !ms.IsSatisfied(x) || ms.Satisfied(x) != 4.0 {
+		t.Errorf("%v should satisfy all clauses: %v", x, ms.Satisfied(x))
+	}
+	ind := base.NewBoolIndividual([]bool{true, true, true}, base.NewFitness([]float64{1.0}))
+	if v := ms.Evaluate(ind); v[0] != 2.0 || ms.IsSatisfied(ind.GetChromosome().([]bool)) {
+		t.Errorf("%v should satisfy 2 clauses: %v", ind, v)
+	}
+
+	wcnf := "p wcnf 2 3 10\n10 1 2 0\n3 -1 0\n2.5 -2 0\n"
+	if ms, err = ParseDIMACS(strings.NewReader(wcnf)); err != nil {
+		t.Fatal(err)
+	}
+	if v := ms.Satisfied([]bool{true, false}); v != 12.5 || ms.TotalWeight() != 15.5 {
+		t.Errorf("wrong weighted satisfaction: %v", v)
+	}
+
+	for _, text := range []string{"1 2 0\n", "p cnf 2 1\n1 3 0\n", "p cnf 2 2\n1 2 0\n", "p cnf 2 1\n1 2\n", "p sat 2 1\n"} {
+		if _, err := ParseDIMACS(strings.NewReader(text)); err == nil {
+			t.Errorf("the invalid formula should fail: %q", text)
+		}
+	}
+	path := filepath.Join(t.TempDir(), "uf3.cnf")
+	if err := ioutil.WriteFile(path, []byte(cnf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if ms, err := LoadDIMACS(path); err != nil || ms.NumClauses() != 4 {
+		t.Errorf("wrong loaded formula: %v", err)
+	}
+
+	ms = GenerateMaxSAT(20, 91, 3)
+	if ms.Len() != 20 || ms.NumClauses() != 91 {
+		t.Errorf("wrong random formula: %v", ms.clauses)
+	}
+	for _, clause := range ms.clauses {
+		if len(clause) != 3 || abs(clause[0]) == abs(clause[1]) || abs(clause[1]) == abs(clause[2]) || abs(clause[0]) == abs(clause[2]) {
+			t.Errorf("the clause should have 3 distinct variables: %v", clause)
+		}
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,384 @@
+package benchmarks
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/sineatos/deag/base"
+)
+
+// PeakFunction is the function of a peak, position, height and width are the parameters of the peak
+type PeakFunction func(x, position []float64, height, width float64) float64
+
+// PeakCone is the cone peak function.
+//
+// `f(\\mathbf{x}) = h - w \\sqrt{\\sum_{i=1}^N (x_i - p_i)^2}`
+func PeakCone(x, position []float64, height, width float64) float64 {
+	return height - width*math.Sqrt(squaredDistance(x, position))
+}
+
+// PeakSphere is the sphere peak function.
+//
+// `f(\\mathbf{x}) = h \\times \\sum_{i=1}^N (x_i - p_i)^2`
+func PeakSphere(x, position []float64, height, width float64) float64 {
+	return height * squaredDistance(x, position)
+}
+
+// PeakFunction1 is the peak function of scenario 1 of [Branke1999].
+//
+// `f(\\mathbf{x}) = \\frac{h}{1 + w \\sum_{i=1}^N (x_i - p_i)^2}`
+func PeakFunction1(x, position []float64, height, width float64) float64 {
+	return height / (1.0 + width*squaredDistance(x, position))
+}
+
+// squaredDistance returns the squared euclidean distance between x and y
+func squaredDistance(x, y []float64) float64 {
+	total := 0.0
+	for i, v := range x {
+		total += (v - y[i]) * (v - y[i])
+	}
+	return total
+}
+
+// MovingPeaksScenario is the parameters of MovingPeaks, the scenarios of [Branke1999] are returned by MovingPeaksScenario1, 2 and 3
+type MovingPeaksScenario struct {
+	// PeakFunctions are the functions of the peaks, they are used in order if there are NPeaks functions, otherwise each peak uses a random one
+	PeakFunctions []PeakFunction
+	// NPeaks is the initial number of peaks
+	NPeaks int
+	// MinPeaks and MaxPeaks are the limits of the number of peaks, the number of peaks is fixed if MaxPeaks <= MinPeaks
+	MinPeaks, MaxPeaks int
+	// NumberSeverity is the severity of the change of the number of peaks
+	NumberSeverity float64
+	// BasisFunction is the landscape under the peaks, optional
+	BasisFunction func(x []float64) float64
+	// MinCoord and MaxCoord are the limits of the coordinates
+	MinCoord, MaxCoord float64
+	// MinHeight and MaxHeight are the limits of the heights
+	MinHeight, MaxHeight float64
+	// UniformHeight is the initial height of all the peaks, the initial heights are random if it is 0
+	UniformHeight float64
+	// MinWidth and MaxWidth are the limits of the widths
+	MinWidth, MaxWidth float64
+	// UniformWidth is the initial width of all the peaks, the initial widths are random if it is 0
+	UniformWidth float64
+	// Lambda is the correlation between the successive movements of a peak
+	Lambda float64
+	// MoveSeverity is the distance of a movement of a peak
+	MoveSeverity float64
+	// HeightSeverity is the standard deviation of the changes of the heights
+	HeightSeverity float64
+	// WidthSeverity is the standard deviation of the changes of the widths
+	WidthSeverity float64
+	// Period is the number of evaluations between two changes, the peaks are only changed by ChangePeaks if Period <= 0
+	Period int
+}
+
+// MovingPeaksScenario1 returns the scenario 1 of [Branke1999]
+func MovingPeaksScenario1() *MovingPeaksScenario {
+	return &MovingPeaksScenario{
+		PeakFunctions:  []PeakFunction{PeakFunction1},
+		NPeaks:         5,
+		MinCoord:       0.0,
+		MaxCoord:       100.0,
+		MinHeight:      30.0,
+		MaxHeight:      70.0,
+		UniformHeight:  50.0,
+		MinWidth:       0.0001,
+		MaxWidth:       0.2,
+		UniformWidth:   0.1,
+		Lambda:         0.0,
+		MoveSeverity:   1.0,
+		HeightSeverity: 7.0,
+		WidthSeverity:  0.01,
+		Period:         5000,
+	}
+}
+
+// MovingPeaksScenario2 returns the scenario 2 of [Branke1999]
+func MovingPeaksScenario2() *MovingPeaksScenario {
+	return &MovingPeaksScenario{
+		PeakFunctions:  []PeakFunction{PeakCone},
+		NPeaks:         10,
+		MinCoord:       0.0,
+		MaxCoord:       100.0,
+		MinHeight:      30.0,
+		MaxHeight:      70.0,
+		UniformHeight:  50.0,
+		MinWidth:       1.0,
+		MaxWidth:       12.0,
+		UniformWidth:   0.0,
+		Lambda:         0.5,
+		MoveSeverity:   1.0,
+		HeightSeverity: 7.0,
+		WidthSeverity:  1.0,
+		Period:         5000,
+	}
+}
+
+// MovingPeaksScenario3 returns the scenario 3 of [Branke1999]
+func MovingPeaksScenario3() *MovingPeaksScenario {
+	return &MovingPeaksScenario{
+		PeakFunctions:  []PeakFunction{PeakCone},
+		NPeaks:         50,
+		BasisFunction:  func(x []float64) float64 { return 10.0 },
+		MinCoord:       0.0,
+		MaxCoord:       100.0,
+		MinHeight:      30.0,
+		MaxHeight:      70.0,
+		UniformHeight:  0.0,
+		MinWidth:       1.0,
+		MaxWidth:       12.0,
+		UniformWidth:   0.0,
+		Lambda:         0.5,
+		MoveSeverity:   1.0,
+		HeightSeverity: 1.0,
+		WidthSeverity:  0.5,
+		Period:         1000,
+	}
+}
+
+// MovingPeaks is the moving peaks benchmark [Branke1999] for the dynamic optimization, it is a maximization problem.
+// The value of x is the maximum of the values of the peaks (and the basis function) at x.
+// Every Period evaluations, the peaks are moved by MoveSeverity and their heights and widths are changed by the Gaussian noises,
+// the peaks exceeding the limits are reflected back.
+//
+// The offline error is the average of the current errors of all the evaluations,
+// the current error is the error of the best individual evaluated since the last change.
+//
+// [Branke1999] Branke, "Memory enhanced evolutionary algorithms for changing optimization problems", 1999.
+type MovingPeaks struct {
+	dim        int
+	sc         MovingPeaksScenario
+	functions  []PeakFunction
+	positions  [][]float64
+	heights    []float64
+	widths     []float64
+	lastChange [][]float64
+
+	optimum      float64
+	hasOptimum   bool
+	err          float64
+	offlineError float64
+	nevals       int
+}
+
+// NewMovingPeaks returns *MovingPeaks of dim dimensions, sc is the scenario such as MovingPeaksScenario1()
+func NewMovingPeaks(dim int, sc *MovingPeaksScenario) *MovingPeaks {
+	if len(sc.PeakFunctions) == 0 {
+		panic("The scenario has no peak function")
+	}
+	mp := &MovingPeaks{dim: dim, sc: *sc}
+	for i := 0; i < sc.NPeaks; i++ {
+		if len(sc.PeakFunctions) == sc.NPeaks {
+			mp.functions = append(mp.functions, sc.PeakFunctions[i])
+		} else {
+			mp.functions = append(mp.functions, sc.PeakFunctions[rand.Intn(len(sc.PeakFunctions))])
+		}
+		mp.positions = append(mp.positions, mp.randomPosition())
+		if sc.UniformHeight != 0.0 {
+			mp.heights = append(mp.heights, sc.UniformHeight)
+		} else {
+			mp.heights = append(mp.heights, uniform(sc.MinHeight, sc.MaxHeight))
+		}
+		if sc.UniformWidth != 0.0 {
+			mp.widths = append(mp.widths, sc.UniformWidth)
+		} else {
+			mp.widths = append(mp.widths, uniform(sc.MinWidth, sc.MaxWidth))
+		}
+		mp.lastChange = append(mp.lastChange, mp.randomChange())
+	}
+	return mp
+}
+
+// Len returns the number of peaks
+func (mp *MovingPeaks) Len() int {
+	return len(mp.functions)
+}
+
+// Value returns the value of x, it isn't counted as an evaluation
+func (mp *MovingPeaks) Value(x []float64) float64 {
+	value := math.Inf(-1)
+	for i, fn := range mp.functions {
+		value = math.Max(value, fn(x, mp.positions[i], mp.heights[i], mp.widths[i]))
+	}
+	if mp.sc.BasisFunction != nil {
+		value = math.Max(value, mp.sc.BasisFunction(x))
+	}
+	return value
+}
+
+// Evaluate is the Float64Evaluator of MovingPeaks, it updates the errors and changes the peaks after every Period evaluations
+func (mp *MovingPeaks) Evaluate(individual *base.Float64Individual) []float64 {
+	x := individual.GetChromosome().([]float64)
+	if len(x) != mp.dim {
+		panic(fmt.Sprintf("The dimension of individual should be %d: %d", mp.dim, len(x)))
+	}
+	fitness := mp.Value(x)
+	mp.nevals++
+	if !mp.hasOptimum {
+		mp.optimum, _ = mp.GlobalMaximum()
+		mp.hasOptimum = true
+		mp.err = math.Abs(fitness - mp.optimum)
+	}
+	mp.err = math.Min(mp.err, math.Abs(fitness-mp.optimum))
+	mp.offlineError += mp.err
+	if mp.sc.Period > 0 && mp.nevals%mp.sc.Period == 0 {
+		mp.ChangePeaks()
+	}
+	return []float64{fitness}
+}
+
+// GlobalMaximum returns the global maximum value and its position (not a copy)
+func (mp *MovingPeaks) GlobalMaximum() (float64, []float64) {
+	best, position := math.Inf(-1), []float64(nil)
+	for i, fn := range mp.functions {
+		if v := fn(mp.positions[i], mp.positions[i], mp.heights[i], mp.widths[i]); v > best {
+			best, position = v, mp.positions[i]
+		}
+	}
+	return best, position
+}
+
+// Maximums returns the values and the positions (not a copy) of the visible peaks in descending order of values,
+// a peak is visible if it isn't covered by the other peaks at its position.
+func (mp *MovingPeaks) Maximums() ([]float64, [][]float64) {
+	indexes := make([]int, 0, len(mp.functions))
+	values := make([]float64, len(mp.functions))
+	for i, fn := range mp.functions {
+		values[i] = fn(mp.positions[i], mp.positions[i], mp.heights[i], mp.widths[i])
+		if values[i] >= mp.Value(mp.positions[i]) {
+			indexes = append(indexes, i)
+		}
+	}
+	sort.SliceStable(indexes, func(a, b int) bool { return values[indexes[a]] > values[indexes[b]] })
+	maxValues, positions := make([]float64, len(indexes)), make([][]float64, len(indexes))
+	for k, i := range indexes {
+		maxValues[k], positions[k] = values[i], mp.positions[i]
+	}
+	return maxValues, positions
+}
+
+// GetNEvals returns the number of evaluations
+func (mp *MovingPeaks) GetNEvals() int {
+	return mp.nevals
+}
+
+// OfflineError returns the offline error, it is NaN if there is no evaluation
+func (mp *MovingPeaks) OfflineError() float64 {
+	if mp.nevals == 0 {
+		return math.NaN()
+	}
+	return mp.offlineError / float64(mp.nevals)
+}
+
+// CurrentError returns the current error, it is NaN if there is no evaluation since the last change
+func (mp *MovingPeaks) CurrentError() float64 {
+	if !mp.hasOptimum {
+		return math.NaN()
+	}
+	return mp.err
+}
+
+// ChangePeaks changes the number, the positions, the heights and the widths of the peaks
+func (mp *MovingPeaks) ChangePeaks() {
+	sc := mp.sc
+	if sc.MaxPeaks > sc.MinPeaks {
+		npeaks := len(mp.functions)
+		r := float64(sc.MaxPeaks - sc.MinPeaks)
+		if rand.Float64() < 0.5 {
+			n := int(math.Min(float64(npeaks-sc.MinPeaks), math.Floor(r*rand.Float64()*sc.NumberSeverity+0.5)))
+			for k := 0; k < n; k++ {
+				i := rand.Intn(len(mp.functions))
+				mp.functions = append(mp.functions[:i], mp.functions[i+1:]...)
+				mp.positions = append(mp.positions[:i], mp.positions[i+1:]...)
+				mp.heights = append(mp.heights[:i], mp.heights[i+1:]...)
+				mp.widths = append(mp.widths[:i], mp.widths[i+1:]...)
+				mp.lastChange = append(mp.lastChange[:i], mp.lastChange[i+1:]...)
+			}
+		} else {
+			n := int(math.Min(float64(sc.MaxPeaks-npeaks), math.Floor(r*rand.Float64()*sc.NumberSeverity+0.5)))
+			for k := 0; k < n; k++ {
+				mp.functions = append(mp.functions, sc.PeakFunctions[rand.Intn(len(sc.PeakFunctions))])
+				mp.positions = append(mp.positions, mp.randomPosition())
+				mp.heights = append(mp.heights, uniform(sc.MinHeight, sc.MaxHeight))
+				mp.widths = append(mp.widths, uniform(sc.MinWidth, sc.MaxWidth))
+				mp.lastChange = append(mp.lastChange, mp.randomChange())
+			}
+		}
+	}
+	for i := range mp.functions {
+		// the shift is the combination of a random vector and the last shift, whose length is MoveSeverity
+		shift := mp.randomChange()
+		scale := severityScale(sc.MoveSeverity, shift)
+		for j, s := range shift {
+			shift[j] = scale*(1.0-sc.Lambda)*s + sc.Lambda*mp.lastChange[i][j]
+		}
+		scale = severityScale(sc.MoveSeverity, shift)
+		for j, s := range shift {
+			s *= scale
+			p := mp.positions[i][j]
+			switch c := p + s; {
+			case c < sc.MinCoord:
+				mp.positions[i][j], shift[j] = 2.0*sc.MinCoord-p-s, -s
+			case c > sc.MaxCoord:
+				mp.positions[i][j], shift[j] = 2.0*sc.MaxCoord-p-s, -s
+			default:
+				mp.positions[i][j], shift[j] = c, s
+			}
+		}
+		mp.lastChange[i] = shift
+		mp.heights[i] = reflectedChange(mp.heights[i], rand.NormFloat64()*sc.HeightSeverity, sc.MinHeight, sc.MaxHeight)
+		mp.widths[i] = reflectedChange(mp.widths[i], rand.NormFloat64()*sc.WidthSeverity, sc.MinWidth, sc.MaxWidth)
+	}
+	mp.hasOptimum = false
+}
+
+// randomPosition returns a random position in the limits of the coordinates
+func (mp *MovingPeaks) randomPosition() []float64 {
+	position := make([]float64, mp.dim)
+	for j := range position {
+		position[j] = uniform(mp.sc.MinCoord, mp.sc.MaxCoord)
+	}
+	return position
+}
+
+// randomChange returns a random vector in [-0.5, 0.5)^dim
+func (mp *MovingPeaks) randomChange() []float64 {
+	change := make([]float64, mp.dim)
+	for j := range change {
+		change[j] = rand.Float64() - 0.5
+	}
+	return change
+}
+
+// severityScale returns the factor scaling v to the length severity, it is 0 if v is a zero vector
+func severityScale(severity float64, v []float64) float64 {
+	length := 0.0
+	for _, s := range v {
+		length += s * s
+	}
+	if length > 0.0 {
+		return severity / math.Sqrt(length)
+	}
+	return 0.0
+}
+
+// reflectedChange returns value + change, which is reflected back into [low, up]
+func reflectedChange(value, change, low, up float64) float64 {
+	switch v := value + change; {
+	case v < low:
+		return 2.0*low - value - change
+	case v > up:
+		return 2.0*up - value - change
+	default:
+		return v
+	}
+}
+
+// uniform returns a random number in [low, up)
+func uniform(low, up float64) float64 {
+	return low + rand.Float64()*(up-low)
+}
@@ -0,0 +1,77 @@
+package benchmarks
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+)
+
+func TestMovingPeaks(t *testing.T) {
+	fit := base.NewFitness([]float64{1.0})
+	sc := MovingPeaksScenario1()
+	sc.Period = 10
+	mp := NewMovingPeaks(5, sc)
+	if mp.Len() != 5 {
+		t.Errorf("there should be 5 peaks: %v", mp.Len())
+	}
+	// all the peaks of scenario 1 have the height 50 at first
+	optimum, position := mp.GlobalMaximum()
+	checkValue(t, 50.0, optimum, 1)
+	values, _ := mp.Maximums()
+	if len(values) == 0 || values[0] != optimum {
+		t.Errorf("the first maximum should be the global maximum: %v, %v", values, optimum)
+	}
+	if !math.IsNaN(mp.OfflineError()) || !math.IsNaN(mp.CurrentError()) {
+		t.Errorf("the errors should be NaN before the evaluations: %v, %v", mp.OfflineError(), mp.CurrentError())
+	}
+	x := make([]float64, 5)
+	copy(x, position)
+	value := mp.Evaluate(base.NewFloat64Individual(x, fit.Clone()))[0]
+	checkValue(t, 50.0, value, 1)
+	checkValue(t, 0.0, mp.CurrentError(), 1)
+	mp.Evaluate(base.NewFloat64Individual([]float64{0.0, 0.0, 0.0, 0.0, 0.0}, fit.Clone()))
+	checkValue(t, 0.0, mp.OfflineError(), 1)
+	// the peaks are moved after 10 evaluations
+	old := make([][]float64, mp.Len())
+	for i, p := range mp.positions {
+		old[i] = append([]float64{}, p...)
+	}
+	for mp.GetNEvals() < 10 {
+		mp.Evaluate(base.NewFloat64Individual([]float64{0.0, 0.0, 0.0, 0.0, 0.0}, fit.Clone()))
+	}
+	if !math.IsNaN(mp.CurrentError()) {
+		t.Errorf("the current error should be reset after the change: %v", mp.CurrentError())
+	}
+	for i, p := range mp.positions {
+		inside := true
+		for j, v := range old[i] {
+			inside = inside && v > 1.0 && v < 99.0
+			if p[j] < 0.0 || p[j] > 100.0 {
+				t.Errorf("the peak is out of bounds: %v", p)
+			}
+		}
+		if inside {
+			checkValue(t, 1.0, math.Sqrt(squaredDistance(old[i], p)), 1)
+		}
+		if mp.heights[i] < 30.0 || mp.heights[i] > 70.0 || mp.widths[i] < 0.0001 || mp.widths[i] > 0.2 {
+			t.Errorf("the height or width is out of bounds: %v, %v", mp.heights[i], mp.widths[i])
+		}
+	}
+	// scenario 3 has the basis function 10 and a variable number of peaks
+	sc = MovingPeaksScenario3()
+	sc.MinPeaks, sc.MaxPeaks, sc.NumberSeverity = 10, 60, 0.5
+	mp = NewMovingPeaks(2, sc)
+	for n := 0; n < 20; n++ {
+		mp.ChangePeaks()
+		if mp.Len() < 10 || mp.Len() > 60 {
+			t.Errorf("the number of peaks should be in [10, 60]: %v", mp.Len())
+		}
+	}
+	if v := mp.Value([]float64{-1000.0, -1000.0}); v != 10.0 {
+		t.Errorf("the value far from the peaks should be the basis 10: %v", v)
+	}
+	checkValue(t, 2.0, PeakCone([]float64{3.0, 4.0}, []float64{0.0, 0.0}, 7.0, 1.0), 1)
+	checkValue(t, 50.0, PeakSphere([]float64{3.0, 4.0}, []float64{0.0, 0.0}, 2.0, 1.0), 1)
+	checkValue(t, 2.0, PeakFunction1([]float64{3.0, 4.0}, []float64{0.0, 0.0}, 52.0, 1.0), 1)
+}
@@ -152,21 +152,17 @@ func DTLZ1(individual *base.Float64Individual, obj int) []float64 {
 		g += math.Pow(ch-0.5, 2.0) - math.Cos(20.0*math.Pi*(ch-0.5))
 	}
 	g *= 100.0
-	f, pr := make([]float64, obj), 1.0
+	f := make([]float64, obj)
+	f[0] = 0.5 * (1.0 + g)
 	for _, ch := range chrom[:obj-1] {
-		f[0] += pr * ch
-		pr = f[0]
+		f[0] *= ch
 	}
-	f[0] *= 0.5 * (1.0 + g)
 	j := 1
 	for m := obj - 2; m >= 0; m-- {
-		pr = 1.0
+		f[j] = 0.5 * (1.0 - chrom[m]) * (1.0 + g)
 		for _, ch := range chrom[:m] {
-			f[j] += pr * ch
-			pr = f[0]
+			f[j] *= ch
 		}
-		f[j] *= 0.5
-		f[j] *= (1.0 - chrom[m]) * (1.0 + g)
 		j++
 	}
 	return f
@@ -196,20 +192,17 @@ func DTLZ2(individual *base.Float64Individual, obj int) []float64 {
 	for _, ch := range xm {
 		g += math.Pow(ch-0.5, 2.0)
 	}
-	f, pr := make([]float64, obj), 1.0
+	f := make([]float64, obj)
+	f[0] = 1.0 + g
 	for _, ch := range xc {
-		f[0] += pr * math.Cos(math.Pi*ch*0.5)
-		pr = f[0]
+		f[0] *= math.Cos(0.5 * math.Pi * ch)
 	}
-	f[0] *= (1.0 + g)
 	j := 1
 	for m := obj - 2; m >= 0; m-- {
-		pr = 1.0
+		f[j] = (1.0 + g) * math.Sin(0.5*math.Pi*xc[m])
 		for _, ch := range xc[:m] {
-			f[j] += pr * math.Cos(math.Pi*ch*0.5)
-			pr = f[j]
+			f[j] *= math.Cos(0.5 * math.Pi * ch)
 		}
-		f[j] *= (1.0 - chrom[m]) * (1.0 + g) * math.Sin(0.5*xc[m]*math.Pi)
 		j++
 	}
 	return f
@@ -240,20 +233,17 @@ func DTLZ3(individual *base.Float64Individual, obj int) []float64 {
 		g += math.Pow(ch-0.5, 2.0) - math.Cos(20.0*math.Pi*(ch-0.5))
 	}
 	g *= 100.0
-	f, pr := make([]float64, obj), 1.0
+	f := make([]float64, obj)
+	f[0] = 1.0 + g
 	for _, ch := range xc {
-		f[0] += pr * math.Cos(math.Pi*ch*0.5)
-		pr = f[0]
+		f[0] *= math.Cos(0.5 * math.Pi * ch)
 	}
-	f[0] *= (1.0 + g)
 	j := 1
 	for m := obj - 2; m >= 0; m-- {
-		pr = 1.0
+		f[j] = (1.0 + g) * math.Sin(0.5*math.Pi*xc[m])
 		for _, ch := range xc[:m] {
-			f[j] += pr * math.Cos(math.Pi*ch*0.5)
-			pr = f[j]
+			f[j] *= math.Cos(0.5 * math.Pi * ch)
 		}
-		f[j] *= (1.0 - chrom[m]) * (1.0 + g) * math.Sin(0.5*xc[m]*math.Pi)
 		j++
 	}
 	return f
@@ -283,20 +273,17 @@ func DTLZ4(individual *base.Float64Individual, obj int, alpha float64) []float64
 	for _, ch := range xm {
 		g += math.Pow(ch-0.5, 2.0)
 	}
-	f, pr := make([]float64, obj), 1.0
+	f := make([]float64, obj)
+	f[0] = 1.0 + g
 	for _, ch := range xc {
-		f[0] += pr * math.Cos(math.Pi*math.Pow(ch, alpha)*0.5)
-		pr = f[0]
+		f[0] *= math.Cos(0.5 * math.Pi * math.Pow(ch, alpha))
 	}
-	f[0] *= (1.0 + g)
 	j := 1
 	for m := obj - 2; m >= 0; m-- {
-		pr = 1.0
+		f[j] = (1.0 + g) * math.Sin(0.5*math.Pi*math.Pow(xc[m], alpha))
 		for _, ch := range xc[:m] {
-			f[j] += pr * math.Cos(math.Pi*math.Pow(ch, alpha)*0.5)
-			pr = f[j]
+			f[j] *= math.Cos(0.5 * math.Pi * math.Pow(ch, alpha))
 		}
-		f[j] *= (1.0 - chrom[m]) * (1.0 + g) * math.Sin(0.5*math.Pow(xc[m], alpha)*math.Pi)
 		j++
 	}
 	return f
@@ -313,11 +300,11 @@ func DTLZ5(individual *base.Float64Individual, obj int) []float64 {
 	}
 
 	theta := func(x float64) float64 {
-		return math.Pi / (4.0 * (1.0 + gval) * (1.0 + 2.0*gval*x))
+		return math.Pi / (4.0 * (1.0 + gval)) * (1.0 + 2.0*gval*x)
 	}
 	fit, t := make([]float64, obj), 1.0
-	for _, a := range chrom[1:] {
-		t *= a
+	for _, a := range chrom[1 : obj-1] {
+		t *= math.Cos(theta(a))
 	}
 	fit[0] = (1 + gval) * math.Cos(math.Pi/2.0*chrom[0]) * t
 	j := 1
@@ -332,6 +319,7 @@ func DTLZ5(individual *base.Float64Individual, obj int) []float64 {
 			t *= math.Sin(theta(chrom[m-1]))
 			fit[j] = (1 + gval) * math.Cos(math.Pi/2.0*chrom[0]) * t
 		}
+		j++
 	}
 	return fit
 }
@@ -347,11 +335,11 @@ func DTLZ6(individual *base.Float64Individual, obj int) []float64 {
 	}
 
 	theta := func(x float64) float64 {
-		return math.Pi / (4.0 * (1.0 + gval) * (1.0 + 2.0*gval*x))
+		return math.Pi / (4.0 * (1.0 + gval)) * (1.0 + 2.0*gval*x)
 	}
 	fit, t := make([]float64, obj), 1.0
-	for _, a := range chrom[1:] {
-		t *= a
+	for _, a := range chrom[1 : obj-1] {
+		t *= math.Cos(theta(a))
 	}
 	fit[0] = (1 + gval) * math.Cos(math.Pi/2.0*chrom[0]) * t
 	j := 1
@@ -366,6 +354,7 @@ func DTLZ6(individual *base.Float64Individual, obj int) []float64 {
 			t *= math.Sin(theta(chrom[m-1]))
 			fit[j] = (1 + gval) * math.Cos(math.Pi/2.0*chrom[0]) * t
 		}
+		j++
 	}
 	return fit
 }
@@ -0,0 +1,75 @@
+package benchmarks
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+)
+
+// paretoOptimalIndividual returns a random individual whose last dim-obj+1 variables are 0.5, which is on the Pareto front of DTLZ1-4
+func paretoOptimalIndividual(dim, obj int) *base.Float64Individual {
+	values := make([]float64, dim)
+	for i := range values {
+		if i < obj-1 {
+			values[i] = rand.Float64()
+		} else {
+			values[i] = 0.5
+		}
+	}
+	return base.NewFloat64Individual(values, base.NewFitness([]float64{-1.0, -1.0, -1.0}))
+}
+
+func TestDTLZ(t *testing.T) {
+	for obj := 2; obj <= 5; obj++ {
+		for n := 0; n < 10; n++ {
+			individual := paretoOptimalIndividual(obj+9, obj)
+			// the Pareto front of DTLZ1 is the hyperplane sum(f) = 0.5
+			total := 0.0
+			for _, f := range DTLZ1(individual, obj) {
+				total += f
+			}
+			checkValue(t, 0.5, total, obj)
+			// the Pareto fronts of DTLZ2-4 are the unit sphere sum(f^2) = 1
+			for _, fit := range [][]float64{DTLZ2(individual, obj), DTLZ3(individual, obj), DTLZ4(individual, obj, 100.0)} {
+				if len(fit) != obj {
+					t.Errorf("Expected %v objectives, got %v", obj, len(fit))
+				}
+				total = 0.0
+				for _, f := range fit {
+					total += f * f
+				}
+				checkValue(t, 1.0, total, obj)
+			}
+			// the Pareto fronts of DTLZ5 and DTLZ6 lie on the unit sphere too, DTLZ6 reaching it when the distance variables are 0
+			zeros := individual.Clone().(*base.Float64Individual)
+			chrom := zeros.GetChromosome().([]float64)
+			for i := obj - 1; i < len(chrom); i++ {
+				chrom[i] = 0.0
+			}
+			for _, fit := range [][]float64{DTLZ5(individual, obj), DTLZ6(zeros, obj)} {
+				if len(fit) != obj {
+					t.Errorf("Expected %v objectives, got %v", obj, len(fit))
+				}
+				total = 0.0
+				for _, f := range fit {
+					total += f * f
+				}
+				checkValue(t, 1.0, total, obj)
+			}
+		}
+	}
+	// a fixed point on the Pareto fronts of DTLZ5 and DTLZ6 with 3 objectives and 12 variables
+	values := []float64{0.3, 0.7, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5}
+	zeros := append([]float64{0.3, 0.7}, make([]float64, 10)...)
+	for _, fit := range [][]float64{
+		DTLZ5(base.NewFloat64Individual(values, base.NewFitness([]float64{-1.0, -1.0, -1.0})), 3),
+		DTLZ6(base.NewFloat64Individual(zeros, base.NewFitness([]float64{-1.0, -1.0, -1.0})), 3),
+	} {
+		total := 0.0
+		for _, f := range fit {
+			total += f * f
+		}
+		checkValue(t, 1.0, total, 3)
+	}
+}
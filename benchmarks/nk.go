@@ -0,0 +1,105 @@
+package benchmarks
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+)
+
+// The neighbourhoods of NKLandscape
+const (
+	// NKAdjacent chooses the K bits following each bit cyclically as its neighbours
+	NKAdjacent = iota
+	// NKRandom chooses K other bits at random as the neighbours of each bit
+	NKRandom
+)
+
+// NKLandscape is the NK fitness landscape of N bits, the contribution of each bit depends on itself and its K neighbours.
+// The value is the mean of the contributions, which should be maximized, and the ruggedness increases with K.
+//
+// From: S. A. Kauffman. The Origins of Order: Self-Organization and Selection in Evolution. Oxford University Press, 1993.
+type NKLandscape struct {
+	n, k       int
+	neighbours [][]int
+	tables     [][]float64 // tables[i] is the contributions of the bit i indexed by the bits of i and its neighbours
+}
+
+// NewNKLandscape returns *NKLandscape of N bits with K neighbours chosen by neighbourhood, the contributions are uniform in [0, 1)
+func NewNKLandscape(N, K, neighbourhood int) *NKLandscape {
+	if N < 1 || K < 0 || K >= N {
+		panic(fmt.Sprintf("Invalid parameters of NK landscape: N=%v, K=%v", N, K))
+	}
+	nk := &NKLandscape{n: N, k: K, neighbours: make([][]int, N), tables: make([][]float64, N)}
+	for i := range nk.neighbours {
+		nk.neighbours[i] = make([]int, K)
+		switch neighbourhood {
+		case NKAdjacent:
+			for j := range nk.neighbours[i] {
+				nk.neighbours[i][j] = (i + j + 1) % N
+			}
+		case NKRandom:
+			// the other bits are chosen by a partial shuffle of the bits except i
+			others := rand.Perm(N - 1)
+			for j := range nk.neighbours[i] {
+				nk.neighbours[i][j] = others[j]
+				if others[j] >= i {
+					nk.neighbours[i][j]++
+				}
+			}
+		default:
+			panic(fmt.Sprintf("Unknown neighbourhood of NK landscape: %v", neighbourhood))
+		}
+		nk.tables[i] = make([]float64, 1<<uint(K+1))
+		for j := range nk.tables[i] {
+			nk.tables[i][j] = rand.Float64()
+		}
+	}
+	return nk
+}
+
+// Len returns N
+func (nk *NKLandscape) Len() int {
+	return nk.n
+}
+
+// GetK returns K
+func (nk *NKLandscape) GetK() int {
+	return nk.k
+}
+
+// GetNeighbours returns the neighbours of the bit i
+func (nk *NKLandscape) GetNeighbours(i int) []int {
+	ans := make([]int, nk.k)
+	copy(ans, nk.neighbours[i])
+	return ans
+}
+
+// Contribution returns the contribution of the bit i of x
+func (nk *NKLandscape) Contribution(x []bool, i int) float64 {
+	index := 0
+	if x[i] {
+		index = 1
+	}
+	for _, j := range nk.neighbours[i] {
+		index <<= 1
+		if x[j] {
+			index |= 1
+		}
+	}
+	return nk.tables[i][index]
+}
+
+// Value returns the mean of the contributions of x
+func (nk *NKLandscape) Value(x []bool) float64 {
+	total := 0.0
+	for i := range nk.tables {
+		total += nk.Contribution(x, i)
+	}
+	return total / float64(nk.n)
+}
+
+// Evaluate is the BoolEvaluator of the landscape
+func (nk *NKLandscape) Evaluate(individual *base.BoolIndividual) []float64 {
+	return []float64{nk.Value(individual.GetChromosome().([]bool))}
+}
@@ -0,0 +1,63 @@
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/sineatos/deag/base"
+)
+
+func TestNKLandscape(t *testing.T) {
+	nk := NewNKLandscape(10, 3, NKAdjacent)
+	if neighbours := nk.GetNeighbours(8); neighbours[0] != 9 || neighbours[1] != 0 || neighbours[2] != 1 {
+		t.Errorf("wrong adjacent neighbours: %v", neighbours)
+	}
+	nk = NewNKLandscape(10, 4, NKRandom)
+	for i := 0; i < nk.Len(); i++ {
+		seen := map[int]bool{i: true}
+		for _, j := range nk.GetNeighbours(i) {
+			if seen[j] || j < 0 || j >= nk.Len() {
+				t.Errorf("the neighbours of %d should be distinct other bits: %v", i, nk.GetNeighbours(i))
+			}
+			seen[j] = true
+		}
+	}
+	for k := 0; k < 10; k++ {
+		ind := base.NewBoolIndividual(genBools(10), base.NewFitness([]float64{1.0}))
+		x := ind.GetChromosome().([]bool)
+		if v := nk.Evaluate(ind)[0]; v < 0.0 || v >= 1.0 {
+			t.Errorf("the value should be in [0, 1): %v", v)
+		}
+		// flipping a bit only changes the contributions of the bits depending on it
+		before := make([]float64, nk.Len())
+		for i := range before {
+			before[i] = nk.Contribution(x, i)
+		}
+		x[3] = !x[3]
+		for i := range before {
+			depends := i == 3
+			for _, j := range nk.GetNeighbours(i) {
+				depends = depends || j == 3
+			}
+			if !depends && nk.Contribution(x, i) != before[i] {
+				t.Errorf("the contribution of %d shouldn't depend on the bit 3", i)
+			}
+		}
+	}
+	// the optimum of K = 0 is the best bit of each locus
+	nk = NewNKLandscape(6, 0, NKAdjacent)
+	x := make([]bool, 6)
+	for i := range x {
+		if nk.tables[i][1] > nk.tables[i][0] {
+			x[i] = true
+		}
+	}
+	for mask := 0; mask < 64; mask++ {
+		y := make([]bool, 6)
+		for i := range y {
+			y[i] = mask&(1<<uint(i)) != 0
+		}
+		if nk.Value(y) > nk.Value(x) {
+			t.Errorf("%v shouldn't be better than the optimum %v", y, x)
+		}
+	}
+}
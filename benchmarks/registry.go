@@ -0,0 +1,617 @@
+package benchmarks
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/bounds"
+)
+
+// BoundsFunc returns the lower bounds and the upper bounds of the variables for the dimension dim
+type BoundsFunc func(dim int) (low, up []float64)
+
+// OptimizerFunc returns the chromosome of an optimal solution for the dimension dim, which is []float64, []int or []bool
+type OptimizerFunc func(dim int) interface{}
+
+// SamplerFunc returns a random chromosome for the dimension dim, which is []float64, []int or []bool
+type SamplerFunc func(dim int) interface{}
+
+// Benchmark describes a registered benchmark: its evaluator, the valid dimensions, the bounds of the variables,
+// the number of objectives, the optimization direction and the known optimum.
+// The evaluator is a Float64Evaluator, an IntEvaluator or a BoolEvaluator, the benchmarks with parameters (such as the number of objectives of DTLZ1)
+// are registered by closures of fixed parameters.
+type Benchmark struct {
+	name         string
+	evaluator    interface{}
+	objectives   int
+	maximization bool
+	minDim       int
+	maxDim       int
+	validDim     func(dim int) bool
+	bounds       BoundsFunc
+	optimum      func(dim int) []float64
+	optimizer    OptimizerFunc
+	sampler      SamplerFunc
+}
+
+// NewBenchmark returns *Benchmark of evaluator, which should be a Float64Evaluator, an IntEvaluator or a BoolEvaluator.
+// The dimension is any positive number, the variables are unbounded and the optimum is unknown until they are set.
+func NewBenchmark(name string, evaluator interface{}, objectives int, maximization bool) *Benchmark {
+	switch evaluator.(type) {
+	case Float64Evaluator, IntEvaluator, BoolEvaluator:
+	default:
+		panic(fmt.Sprintf("The evaluator of the benchmark %s should be Float64Evaluator, IntEvaluator or BoolEvaluator: %T", name, evaluator))
+	}
+	if objectives < 1 {
+		panic(fmt.Sprintf("The benchmark %s should have at least one objective: %v", name, objectives))
+	}
+	return &Benchmark{name: name, evaluator: evaluator, objectives: objectives, maximization: maximization, minDim: 1}
+}
+
+// SetDims sets the valid dimensions in [min, max], max <= 0 means no upper limit. valid checks the other constraints of the dimension, it can be nil.
+func (b *Benchmark) SetDims(min, max int, valid func(dim int) bool) *Benchmark {
+	b.minDim, b.maxDim, b.validDim = min, max, valid
+	return b
+}
+
+// SetBounds sets the bounds of the variables, it is ignored by the IntEvaluator and the BoolEvaluator benchmarks
+func (b *Benchmark) SetBounds(bounds BoundsFunc) *Benchmark {
+	b.bounds = bounds
+	return b
+}
+
+// SetOptimum sets the known optimal objective values and an optimal solution for the dimension, either of them can be nil if it isn't known
+func (b *Benchmark) SetOptimum(optimum func(dim int) []float64, optimizer OptimizerFunc) *Benchmark {
+	b.optimum, b.optimizer = optimum, optimizer
+	return b
+}
+
+// SetSampler sets the sampler of the random chromosomes used by Sample, such as the random operation-based encodings of a job-shop problem
+func (b *Benchmark) SetSampler(sampler SamplerFunc) *Benchmark {
+	b.sampler = sampler
+	return b
+}
+
+// GetName returns the name of the benchmark
+func (b *Benchmark) GetName() string {
+	return b.name
+}
+
+// Objectives returns the number of objectives
+func (b *Benchmark) Objectives() int {
+	return b.objectives
+}
+
+// IsMaximization returns if the objectives should be maximized, they should be minimized otherwise
+func (b *Benchmark) IsMaximization() bool {
+	return b.maximization
+}
+
+// GetWeights returns the weights of the objectives for base.NewFitness, which are 1 for maximization and -1 for minimization
+func (b *Benchmark) GetWeights() []float64 {
+	if b.maximization {
+		return repeat(1.0, b.objectives)
+	}
+	return repeat(-1.0, b.objectives)
+}
+
+// IsFloat64 returns if the evaluator is a Float64Evaluator
+func (b *Benchmark) IsFloat64() bool {
+	_, ok := b.evaluator.(Float64Evaluator)
+	return ok
+}
+
+// IsInt returns if the evaluator is an IntEvaluator
+func (b *Benchmark) IsInt() bool {
+	_, ok := b.evaluator.(IntEvaluator)
+	return ok
+}
+
+// IsBool returns if the evaluator is a BoolEvaluator
+func (b *Benchmark) IsBool() bool {
+	_, ok := b.evaluator.(BoolEvaluator)
+	return ok
+}
+
+// GetFloat64Evaluator returns the Float64Evaluator of the benchmark, it panics if the benchmark isn't evaluated on Float64Individual
+func (b *Benchmark) GetFloat64Evaluator() Float64Evaluator {
+	evaluator, ok := b.evaluator.(Float64Evaluator)
+	if !ok {
+		panic(fmt.Sprintf("The benchmark %s isn't evaluated on Float64Individual", b.name))
+	}
+	return evaluator
+}
+
+// GetIntEvaluator returns the IntEvaluator of the benchmark, it panics if the benchmark isn't evaluated on IntIndividual
+func (b *Benchmark) GetIntEvaluator() IntEvaluator {
+	evaluator, ok := b.evaluator.(IntEvaluator)
+	if !ok {
+		panic(fmt.Sprintf("The benchmark %s isn't evaluated on IntIndividual", b.name))
+	}
+	return evaluator
+}
+
+// GetBoolEvaluator returns the BoolEvaluator of the benchmark, it panics if the benchmark isn't evaluated on BoolIndividual
+func (b *Benchmark) GetBoolEvaluator() BoolEvaluator {
+	evaluator, ok := b.evaluator.(BoolEvaluator)
+	if !ok {
+		panic(fmt.Sprintf("The benchmark %s isn't evaluated on BoolIndividual", b.name))
+	}
+	return evaluator
+}
+
+// GetDims returns the minimum and the maximum dimensions, the maximum is 0 if there is no upper limit
+func (b *Benchmark) GetDims() (int, int) {
+	if b.maxDim <= 0 {
+		return b.minDim, 0
+	}
+	return b.minDim, b.maxDim
+}
+
+// CheckDim returns the error if the benchmark isn't defined for the dimension dim
+func (b *Benchmark) CheckDim(dim int) error {
+	if dim < b.minDim || (b.maxDim > 0 && dim > b.maxDim) {
+		if b.maxDim <= 0 {
+			return fmt.Errorf("the dimension of %s should be at least %d: %d", b.name, b.minDim, dim)
+		}
+		return fmt.Errorf("the dimension of %s should be in [%d, %d]: %d", b.name, b.minDim, b.maxDim, dim)
+	}
+	if b.validDim != nil && !b.validDim(dim) {
+		return fmt.Errorf("invalid dimension of %s: %d", b.name, dim)
+	}
+	return nil
+}
+
+// GetBounds returns the lower bounds and the upper bounds of the variables for the dimension dim, they are -Inf and +Inf if the variables are unbounded
+func (b *Benchmark) GetBounds(dim int) ([]float64, []float64) {
+	b.mustDim(dim)
+	if b.bounds == nil {
+		return repeat(math.Inf(-1), dim), repeat(math.Inf(1), dim)
+	}
+	return b.bounds(dim)
+}
+
+// GetBoundsHandler returns the *bounds.Bounds of the variables for the dimension dim repaired by handler
+func (b *Benchmark) GetBoundsHandler(handler bounds.Handler, dim int) *bounds.Bounds {
+	low, up := b.GetBounds(dim)
+	return bounds.NewBounds(low, up, handler, dim)
+}
+
+// InBounds returns if each variable of x is in its bounds
+func (b *Benchmark) InBounds(x []float64) bool {
+	low, up := b.GetBounds(len(x))
+	for i, v := range x {
+		if v < low[i] || v > up[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// HasOptimum returns if the optimal objective values are known
+func (b *Benchmark) HasOptimum() bool {
+	return b.optimum != nil
+}
+
+// GetOptimum returns the optimal objective values for the dimension dim, it returns nil if they aren't known, such as the multi-objective benchmarks
+func (b *Benchmark) GetOptimum(dim int) []float64 {
+	b.mustDim(dim)
+	if b.optimum == nil {
+		return nil
+	}
+	return b.optimum(dim)
+}
+
+// GetOptimizer returns the chromosome of an optimal solution for the dimension dim, it returns nil if it isn't known
+func (b *Benchmark) GetOptimizer(dim int) interface{} {
+	b.mustDim(dim)
+	if b.optimizer == nil {
+		return nil
+	}
+	return b.optimizer(dim)
+}
+
+// Error returns the largest absolute difference between values and the optimal objective values for the dimension dim, it panics if the optimum isn't known
+func (b *Benchmark) Error(values []float64, dim int) float64 {
+	optimum := b.GetOptimum(dim)
+	if optimum == nil {
+		panic(fmt.Sprintf("The optimum of the benchmark %s isn't known", b.name))
+	}
+	if len(values) != len(optimum) {
+		panic(fmt.Sprintf("The benchmark %s has %d objectives: %v", b.name, len(optimum), values))
+	}
+	ans := 0.0
+	for i, v := range values {
+		ans = math.Max(ans, math.Abs(v-optimum[i]))
+	}
+	return ans
+}
+
+// IsSolved returns if values reaches the optimum for the dimension dim within tolerance, it panics if the optimum isn't known
+func (b *Benchmark) IsSolved(values []float64, dim int, tolerance float64) bool {
+	return b.Error(values, dim) <= tolerance
+}
+
+// Sample returns a random chromosome for the dimension dim by the sampler set by SetSampler. Without the sampler,
+// the variables of a Float64Evaluator are uniform in their bounds, where the unbounded side is replaced by 10 (or -10),
+// the chromosome of an IntEvaluator is a random permutation of [0, dim) and the bits of a BoolEvaluator are random.
+func (b *Benchmark) Sample(dim int) interface{} {
+	b.mustDim(dim)
+	if b.sampler != nil {
+		return b.sampler(dim)
+	}
+	switch b.evaluator.(type) {
+	case Float64Evaluator:
+		low, up := b.GetBounds(dim)
+		x := make([]float64, dim)
+		for i := range x {
+			l, u := low[i], up[i]
+			if math.IsInf(l, -1) {
+				l = math.Min(-10.0, u-1.0)
+			}
+			if math.IsInf(u, 1) {
+				u = math.Max(10.0, l+1.0)
+			}
+			x[i] = l + rand.Float64()*(u-l)
+		}
+		return x
+	case IntEvaluator:
+		return rand.Perm(dim)
+	case BoolEvaluator:
+		x := make([]bool, dim)
+		for i := range x {
+			x[i] = rand.Float64() < 0.5
+		}
+		return x
+	}
+	return nil
+}
+
+// Evaluate returns the objective values of chromosome, which is []float64, []int or []bool as the evaluator of the benchmark
+func (b *Benchmark) Evaluate(chromosome interface{}) []float64 {
+	fitness := base.NewFitness(b.GetWeights())
+	switch evaluator := b.evaluator.(type) {
+	case Float64Evaluator:
+		return evaluator(base.NewFloat64Individual(chromosome.([]float64), fitness))
+	case IntEvaluator:
+		return evaluator(base.NewIntIndividual(chromosome.([]int), fitness))
+	case BoolEvaluator:
+		return evaluator(base.NewBoolIndividual(chromosome.([]bool), fitness))
+	}
+	return nil
+}
+
+func (b *Benchmark) String() string {
+	direction := "minimization"
+	if b.maximization {
+		direction = "maximization"
+	}
+	return fmt.Sprintf("%s (%d objectives, %s)", b.name, b.objectives, direction)
+}
+
+// mustDim panics if the benchmark isn't defined for the dimension dim
+func (b *Benchmark) mustDim(dim int) {
+	if err := b.CheckDim(dim); err != nil {
+		panic(err.Error())
+	}
+}
+
+var registeredBenchmarks = make(map[string]*Benchmark)
+
+// RegisterBenchmark registers b by its name, which is used by GetBenchmark
+func RegisterBenchmark(b *Benchmark) {
+	registeredBenchmarks[b.name] = b
+}
+
+// GetBenchmark returns the registered Benchmark named name, such as "sphere", "zdt1" and "trap", see BenchmarkNames.
+// The benchmarks of the subpackages, such as "bbob_f001", "tsp_burma14" and "flowshop_ta001", are registered when the subpackages are imported.
+func GetBenchmark(name string) *Benchmark {
+	b, ok := registeredBenchmarks[name]
+	if !ok {
+		panic(fmt.Sprintf("Unknown benchmark: %s", name))
+	}
+	return b
+}
+
+// LookupBenchmark returns the registered Benchmark named name and if it is registered
+func LookupBenchmark(name string) (*Benchmark, bool) {
+	b, ok := registeredBenchmarks[name]
+	return b, ok
+}
+
+// BenchmarkNames returns the sorted names of the registered benchmarks
+func BenchmarkNames() []string {
+	names := make([]string, 0, len(registeredBenchmarks))
+	for name := range registeredBenchmarks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PerDimension returns the function that returns the instance of a dimension, which is built by build at the first call for the dimension.
+// It is used by the benchmarks whose instance depends on the dimension, such as the random instances and the rotations of BBOB.
+// The random instances are generated from math/rand, which should be seeded for the reproducible instances.
+func PerDimension(build func(dim int) interface{}) func(dim int) interface{} {
+	var mutex sync.Mutex
+	instances := make(map[int]interface{})
+	return func(dim int) interface{} {
+		mutex.Lock()
+		defer mutex.Unlock()
+		instance, ok := instances[dim]
+		if !ok {
+			instance = build(dim)
+			instances[dim] = instance
+		}
+		return instance
+	}
+}
+
+// UniformBounds returns the BoundsFunc where every variable is in [low, up]
+func UniformBounds(low, up float64) BoundsFunc {
+	return func(dim int) ([]float64, []float64) {
+		return repeat(low, dim), repeat(up, dim)
+	}
+}
+
+// firstBounds returns the BoundsFunc where the first n variables are in [low1, up1] and the others are in [low, up]
+func firstBounds(n int, low1, up1, low, up float64) BoundsFunc {
+	return func(dim int) ([]float64, []float64) {
+		l, u := repeat(low, dim), repeat(up, dim)
+		for i := 0; i < n && i < dim; i++ {
+			l[i], u[i] = low1, up1
+		}
+		return l, u
+	}
+}
+
+// constantOptimum returns the optimum of the values for any dimension
+func constantOptimum(vs ...float64) func(dim int) []float64 {
+	return func(dim int) []float64 {
+		return append([]float64(nil), vs...)
+	}
+}
+
+// pointOptimizer returns the optimizer of the variables all equal to v
+func pointOptimizer(v float64) OptimizerFunc {
+	return func(dim int) interface{} {
+		return repeat(v, dim)
+	}
+}
+
+// boolOptimizer returns the optimizer of the bits all equal to v
+func boolOptimizer(v bool) OptimizerFunc {
+	return func(dim int) interface{} {
+		ans := make([]bool, dim)
+		for i := range ans {
+			ans[i] = v
+		}
+		return ans
+	}
+}
+
+// init registers the benchmarks of this package except the constrained problems, which are registered as benchmarks by RegisterConstrainedProblem.
+// The multi-objective benchmarks of any number of objectives are registered with 3 objectives (DTLZ) or 2 objectives and k = 4 (WFG),
+// Shekel is registered with the 5 maxima of its documentation and the royal roads with the order 8.
+// The constrained functions CF1-CF10 are registered by their objectives, the constraints are given by CFViolations.
+// The knapsack, MaxSAT, NK and moving peaks benchmarks are random instances built for each dimension by PerDimension,
+// the symbolic regressions are registered as the benchmarks of their targets by RegisterSymbolicRegression.
+func init() {
+	// single objective
+	RegisterBenchmark(NewBenchmark("sphere", Float64Evaluator(Sphere), 1, false).
+		SetOptimum(constantOptimum(0.0), pointOptimizer(0.0)))
+	RegisterBenchmark(NewBenchmark("rosenbrock", Float64Evaluator(Rosenbrock), 1, false).
+		SetDims(2, 0, nil).SetOptimum(constantOptimum(0.0), pointOptimizer(1.0)))
+	RegisterBenchmark(NewBenchmark("h1", Float64Evaluator(H1), 1, true).
+		SetDims(2, 2, nil).SetBounds(UniformBounds(-100.0, 100.0)).
+		SetOptimum(constantOptimum(1.99999999992158), func(dim int) interface{} { return []float64{8.6998, 6.7665} }))
+	RegisterBenchmark(NewBenchmark("ackley", Float64Evaluator(Ackley), 1, false).
+		SetBounds(UniformBounds(-15.0, 30.0)).SetOptimum(constantOptimum(0.0), pointOptimizer(0.0)))
+	RegisterBenchmark(NewBenchmark("bohachevsky", Float64Evaluator(Bohachevsky), 1, false).
+		SetDims(2, 0, nil).SetBounds(UniformBounds(-100.0, 100.0)).SetOptimum(constantOptimum(0.0), pointOptimizer(0.0)))
+	RegisterBenchmark(NewBenchmark("rastrigin", Float64Evaluator(Rastrigin), 1, false).
+		SetBounds(UniformBounds(-5.12, 5.12)).SetOptimum(constantOptimum(0.0), pointOptimizer(0.0)))
+	RegisterBenchmark(NewBenchmark("rastrigin_scaled", Float64Evaluator(RastriginScaled), 1, false).
+		SetDims(2, 0, nil).SetBounds(UniformBounds(-5.12, 5.12)).SetOptimum(constantOptimum(0.0), pointOptimizer(0.0)))
+	RegisterBenchmark(NewBenchmark("rastrigin_skew", Float64Evaluator(RastriginSkew), 1, false).
+		SetBounds(UniformBounds(-5.12, 5.12)).SetOptimum(constantOptimum(0.0), pointOptimizer(0.0)))
+	RegisterBenchmark(NewBenchmark("schaffer", Float64Evaluator(Schaffer), 1, false).
+		SetBounds(UniformBounds(-100.0, 100.0)).SetOptimum(constantOptimum(0.0), pointOptimizer(0.0)))
+	RegisterBenchmark(NewBenchmark("schwefel", Float64Evaluator(Schwefel), 1, false).
+		SetBounds(UniformBounds(-500.0, 500.0)).SetOptimum(constantOptimum(0.0), pointOptimizer(420.96874636)))
+	RegisterBenchmark(NewBenchmark("himmelblau", Float64Evaluator(Himmelblau), 1, false).
+		SetDims(2, 2, nil).SetBounds(UniformBounds(-6.0, 6.0)).
+		SetOptimum(constantOptimum(0.0), func(dim int) interface{} { return []float64{3.0, 2.0} }))
+	RegisterBenchmark(NewBenchmark("cigar", Float64Evaluator(Cigar), 1, false).
+		SetOptimum(constantOptimum(0.0), pointOptimizer(0.0)))
+	RegisterBenchmark(NewBenchmark("plane", Float64Evaluator(Plane), 1, false).
+		SetOptimum(constantOptimum(0.0), pointOptimizer(0.0)))
+	RegisterBenchmark(NewBenchmark("rand", Float64Evaluator(Rand), 1, false))
+	shekelA := [][]float64{{0.5, 0.5}, {0.25, 0.25}, {0.25, 0.75}, {0.75, 0.25}, {0.75, 0.75}}
+	shekelC := []float64{0.002, 0.005, 0.005, 0.005, 0.005}
+	RegisterBenchmark(NewBenchmark("shekel", Float64Evaluator(func(individual *base.Float64Individual) []float64 {
+		return Shekel(individual, shekelA, shekelC)
+	}), 1, true).SetDims(2, 2, nil).SetBounds(UniformBounds(0.0, 1.0)))
+
+	// binary
+	RegisterBenchmark(NewBenchmark("trap", BoolEvaluator(Trap), 1, true).
+		SetOptimum(func(dim int) []float64 { return []float64{float64(dim)} }, boolOptimizer(true)))
+	RegisterBenchmark(NewBenchmark("inv_trap", BoolEvaluator(InvTrap), 1, true).
+		SetOptimum(func(dim int) []float64 { return []float64{float64(dim)} }, boolOptimizer(false)))
+	RegisterBenchmark(NewBenchmark("chuang_f1", BoolEvaluator(ChuangF1), 1, true).
+		SetDims(41, 41, nil).SetOptimum(constantOptimum(40.0), boolOptimizer(true)))
+	RegisterBenchmark(NewBenchmark("chuang_f2", BoolEvaluator(ChuangF2), 1, true).
+		SetDims(41, 41, nil).SetOptimum(constantOptimum(40.0), boolOptimizer(true)))
+	RegisterBenchmark(NewBenchmark("chuang_f3", BoolEvaluator(ChuangF3), 1, true).
+		SetDims(41, 41, nil).SetOptimum(constantOptimum(40.0), boolOptimizer(false)))
+	RegisterBenchmark(NewBenchmark("hiff", BoolEvaluator(HIFF), 1, true).
+		SetDims(1, 0, func(dim int) bool { return dim&(dim-1) == 0 }).
+		SetOptimum(func(dim int) []float64 { return []float64{float64(dim) * (math.Log2(float64(dim)) + 1.0)} }, boolOptimizer(true)))
+	royalRoadDim := func(dim int) bool { return dim%8 == 0 }
+	RegisterBenchmark(NewBenchmark("royal_road1", BoolEvaluator(func(individual *base.BoolIndividual) []float64 {
+		return RoyalRoad1(individual, 8)
+	}), 1, true).SetDims(8, 0, royalRoadDim).
+		SetOptimum(func(dim int) []float64 { return []float64{float64(dim)} }, boolOptimizer(true)))
+	RegisterBenchmark(NewBenchmark("royal_road2", BoolEvaluator(func(individual *base.BoolIndividual) []float64 {
+		return RoyalRoad2(individual, 8)
+	}), 1, true).SetDims(8, 0, royalRoadDim).
+		SetOptimum(func(dim int) []float64 {
+			// each level of the blocks of order 8, 16, ..., 128 scores the bits of its complete blocks
+			total := 0.0
+			for order := 8; order < 256; order *= 2 {
+				total += float64(dim / order * order)
+			}
+			return []float64{total}
+		}, boolOptimizer(true)))
+
+	// random instances, the knapsacks are repaired before the evaluation
+	for name, knapsacks := range map[string]int{"knapsack": 1, "knapsack2": 2} {
+		knapsacks := knapsacks
+		instances := PerDimension(func(dim int) interface{} { return GenerateKnapsack(dim, knapsacks) })
+		RegisterBenchmark(NewBenchmark(name, BoolEvaluator(func(individual *base.BoolIndividual) []float64 {
+			ks := instances(individual.Len()).(*Knapsack)
+			return ks.Evaluate(ks.Repair(individual.Clone().(*base.BoolIndividual)))
+		}), knapsacks, true))
+	}
+	maxSATs := PerDimension(func(dim int) interface{} { return GenerateMaxSAT(dim, int(math.Round(4.26*float64(dim))), 3) })
+	RegisterBenchmark(NewBenchmark("maxsat", BoolEvaluator(func(individual *base.BoolIndividual) []float64 {
+		return maxSATs(individual.Len()).(*MaxSAT).Evaluate(individual)
+	}), 1, true).SetDims(3, 0, nil))
+	landscapes := PerDimension(func(dim int) interface{} { return NewNKLandscape(dim, 2, NKAdjacent) })
+	RegisterBenchmark(NewBenchmark("nk", BoolEvaluator(func(individual *base.BoolIndividual) []float64 {
+		return landscapes(individual.Len()).(*NKLandscape).Evaluate(individual)
+	}), 1, true).SetDims(3, 0, nil))
+
+	// dynamic, the peaks of each dimension move after every Period evaluations of the dimension
+	for i, scenario := range []func() *MovingPeaksScenario{MovingPeaksScenario1, MovingPeaksScenario2, MovingPeaksScenario3} {
+		scenario := scenario
+		sc := scenario()
+		instances := PerDimension(func(dim int) interface{} { return NewMovingPeaks(dim, scenario()) })
+		RegisterBenchmark(NewBenchmark(fmt.Sprintf("moving_peaks%d", i+1), Float64Evaluator(func(individual *base.Float64Individual) []float64 {
+			return instances(individual.Len()).(*MovingPeaks).Evaluate(individual)
+		}), 1, true).SetBounds(UniformBounds(sc.MinCoord, sc.MaxCoord)))
+	}
+
+	// boolean problems of GP, the value is 1 if the output of the bits is true and 0 otherwise
+	boolValue := func(output bool) []float64 {
+		if output {
+			return []float64{1.0}
+		}
+		return []float64{0.0}
+	}
+	RegisterBenchmark(NewBenchmark("parity", BoolEvaluator(func(individual *base.BoolIndividual) []float64 {
+		return boolValue(Parity(individual.GetChromosome().([]bool)))
+	}), 1, true).SetOptimum(constantOptimum(1.0), boolOptimizer(false)))
+	// the multiplexer of s select lines has s + 2^s bits
+	selectLines := func(dim int) int {
+		s := 1
+		for s+(1<<uint(s)) < dim {
+			s++
+		}
+		return s
+	}
+	RegisterBenchmark(NewBenchmark("multiplexer", BoolEvaluator(func(individual *base.BoolIndividual) []float64 {
+		return boolValue(Multiplexer(individual.GetChromosome().([]bool), selectLines(individual.Len())))
+	}), 1, true).SetDims(3, 0, func(dim int) bool {
+		s := selectLines(dim)
+		return s+(1<<uint(s)) == dim
+	}).SetOptimum(constantOptimum(1.0), boolOptimizer(true)))
+
+	// multi objectives
+	RegisterBenchmark(NewBenchmark("kursawe", Float64Evaluator(Kursawe), 2, false).
+		SetDims(2, 0, nil).SetBounds(UniformBounds(-5.0, 5.0)))
+	RegisterBenchmark(NewBenchmark("schaffer_mo", Float64Evaluator(SchafferMo), 2, false).
+		SetDims(1, 1, nil).SetBounds(UniformBounds(-1000.0, 1000.0)))
+	RegisterBenchmark(NewBenchmark("fonseca", Float64Evaluator(Fonseca), 2, false).
+		SetBounds(UniformBounds(-4.0, 4.0)))
+	RegisterBenchmark(NewBenchmark("poloni", Float64Evaluator(Poloni), 2, false).
+		SetDims(2, 2, nil).SetBounds(UniformBounds(-math.Pi, math.Pi)))
+	RegisterBenchmark(NewBenchmark("dent", Float64Evaluator(Dent), 2, false).
+		SetDims(2, 2, nil).SetBounds(UniformBounds(-1.5, 1.5)))
+	for name, zdt := range map[string]Float64Evaluator{"zdt1": ZDT1, "zdt2": ZDT2, "zdt3": ZDT3, "zdt6": ZDT6} {
+		RegisterBenchmark(NewBenchmark(name, zdt, 2, false).SetDims(2, 0, nil).SetBounds(UniformBounds(0.0, 1.0)))
+	}
+	RegisterBenchmark(NewBenchmark("zdt4", Float64Evaluator(ZDT4), 2, false).
+		SetDims(2, 0, nil).SetBounds(firstBounds(1, 0.0, 1.0, -5.0, 5.0)))
+	dtlz := map[string]Float64Evaluator{
+		"dtlz1": func(individual *base.Float64Individual) []float64 { return DTLZ1(individual, 3) },
+		"dtlz2": func(individual *base.Float64Individual) []float64 { return DTLZ2(individual, 3) },
+		"dtlz3": func(individual *base.Float64Individual) []float64 { return DTLZ3(individual, 3) },
+		"dtlz4": func(individual *base.Float64Individual) []float64 { return DTLZ4(individual, 3, 100.0) },
+		"dtlz5": func(individual *base.Float64Individual) []float64 { return DTLZ5(individual, 3) },
+		"dtlz6": func(individual *base.Float64Individual) []float64 { return DTLZ6(individual, 3) },
+		"dtlz7": func(individual *base.Float64Individual) []float64 { return DTLZ7(individual, 3) },
+	}
+	for name, evaluator := range dtlz {
+		RegisterBenchmark(NewBenchmark(name, evaluator, 3, false).SetDims(3, 0, nil).SetBounds(UniformBounds(0.0, 1.0)))
+	}
+	wfgBounds := func(dim int) ([]float64, []float64) {
+		up := make([]float64, dim)
+		for i := range up {
+			up[i] = 2.0 * float64(i+1)
+		}
+		return repeat(0.0, dim), up
+	}
+	wfgEven := func(dim int) bool { return (dim-4)%2 == 0 }
+	for i, wfg := range []func(*base.Float64Individual, int, int) []float64{WFG1, WFG2, WFG3, WFG4, WFG5, WFG6, WFG7, WFG8, WFG9} {
+		wfg := wfg
+		b := NewBenchmark(fmt.Sprintf("wfg%d", i+1), Float64Evaluator(func(individual *base.Float64Individual) []float64 {
+			return wfg(individual, 2, 4)
+		}), 2, false).SetBounds(wfgBounds)
+		if i == 1 || i == 2 {
+			b.SetDims(6, 0, wfgEven)
+		} else {
+			b.SetDims(5, 0, nil)
+		}
+		RegisterBenchmark(b)
+	}
+	uf := []Float64Evaluator{UF1, UF2, UF3, UF4, UF5, UF6, UF7, UF8, UF9, UF10}
+	for i, evaluator := range uf {
+		if i >= 7 {
+			// UF8-UF10 have 3 objectives, J1, J2 and J3 are non-empty for at least 5 variables
+			RegisterBenchmark(NewBenchmark(fmt.Sprintf("uf%d", i+1), evaluator, 3, false).
+				SetDims(5, 0, nil).SetBounds(firstBounds(2, 0.0, 1.0, -2.0, 2.0)))
+			continue
+		}
+		b := NewBenchmark(fmt.Sprintf("uf%d", i+1), evaluator, 2, false).SetDims(3, 0, nil)
+		switch i + 1 {
+		case 3:
+			b.SetBounds(UniformBounds(0.0, 1.0))
+		case 4:
+			b.SetBounds(firstBounds(1, 0.0, 1.0, -2.0, 2.0))
+		default:
+			b.SetBounds(firstBounds(1, 0.0, 1.0, -1.0, 1.0))
+		}
+		RegisterBenchmark(b)
+	}
+	cf := []func(*base.Float64Individual) ([]float64, []float64){CF1, CF2, CF3, CF4, CF5, CF6, CF7, CF8, CF9, CF10}
+	for i, evaluator := range cf {
+		if i >= 7 {
+			// CF8-CF10 have 3 objectives as UF8-UF10
+			up := 2.0
+			if i == 7 {
+				up = 4.0
+			}
+			RegisterBenchmark(NewBenchmark(fmt.Sprintf("cf%d", i+1), CFEvaluator(evaluator), 3, false).
+				SetDims(5, 0, nil).SetBounds(firstBounds(2, 0.0, 1.0, -up, up)))
+			continue
+		}
+		b := NewBenchmark(fmt.Sprintf("cf%d", i+1), CFEvaluator(evaluator), 2, false).SetDims(3, 0, nil)
+		switch i + 1 {
+		case 1:
+			b.SetBounds(UniformBounds(0.0, 1.0))
+		case 2:
+			b.SetBounds(firstBounds(1, 0.0, 1.0, -1.0, 1.0))
+		case 6, 7:
+			// the second constraint of CF6 and CF7 is on x_4
+			b.SetDims(4, 0, nil).SetBounds(firstBounds(1, 0.0, 1.0, -2.0, 2.0))
+		default:
+			b.SetBounds(firstBounds(1, 0.0, 1.0, -2.0, 2.0))
+		}
+		RegisterBenchmark(b)
+	}
+}
@@ -0,0 +1,146 @@
+package benchmarks
+
+import (
+	"math"
+	"testing"
+)
+
+// validDim returns the smallest valid dimension of b which is at least dim, or at least its minimum dimension if dim is too large
+func validDim(b *Benchmark, dim int) int {
+	if min, max := b.GetDims(); dim < min || (max > 0 && dim > max) {
+		dim = min
+	}
+	for b.CheckDim(dim) != nil {
+		dim++
+	}
+	return dim
+}
+
+func TestRegisteredBenchmarks(t *testing.T) {
+	names := BenchmarkNames()
+	if len(names) == 0 {
+		t.Fatal("There should be registered benchmarks")
+	}
+	for _, name := range names {
+		b := GetBenchmark(name)
+		dim := validDim(b, 10)
+		x := b.Sample(dim)
+		switch {
+		case b.IsFloat64():
+			low, up := b.GetBounds(dim)
+			if len(low) != dim || len(up) != dim {
+				t.Fatalf("%s: the bounds should have %d variables: %v, %v", name, dim, low, up)
+			}
+			if !b.InBounds(x.([]float64)) {
+				t.Errorf("%s: the random point should be in the bounds: %v", name, x)
+			}
+		case b.IsInt():
+			if len(x.([]int)) != dim {
+				t.Errorf("%s: the random chromosome should have %d elements: %v", name, dim, x)
+			}
+		case b.IsBool():
+			if len(x.([]bool)) != dim {
+				t.Errorf("%s: the random chromosome should have %d bits: %v", name, dim, x)
+			}
+		default:
+			t.Fatalf("%s: unknown evaluator", name)
+		}
+		values := b.Evaluate(x)
+		if len(values) != b.Objectives() {
+			t.Errorf("%s: the evaluator should return %d objectives: %v", name, b.Objectives(), values)
+		}
+		if len(b.GetWeights()) != b.Objectives() {
+			t.Errorf("%s: the weights should be given for %d objectives: %v", name, b.Objectives(), b.GetWeights())
+		}
+		if !b.HasOptimum() {
+			continue
+		}
+		if len(b.GetOptimum(dim)) != b.Objectives() {
+			t.Errorf("%s: the optimum should have %d objectives: %v", name, b.Objectives(), b.GetOptimum(dim))
+		}
+		optimizer := b.GetOptimizer(dim)
+		if optimizer == nil {
+			continue
+		}
+		if x, ok := optimizer.([]float64); ok && !b.InBounds(x) {
+			t.Errorf("%s: the optimizer should be in the bounds: %v", name, x)
+		}
+		// the optimizers of the constrained problems are rounded, so the tolerance is relative
+		tolerance := 1e-6
+		for _, v := range b.GetOptimum(dim) {
+			tolerance = math.Max(tolerance, 1e-6*math.Abs(v))
+		}
+		if values := b.Evaluate(optimizer); !b.IsSolved(values, dim, tolerance) {
+			t.Errorf("%s: the optimizer should reach the optimum %v: %v", name, b.GetOptimum(dim), values)
+		}
+	}
+}
+
+func TestBenchmarkDims(t *testing.T) {
+	if err := GetBenchmark("himmelblau").CheckDim(3); err == nil {
+		t.Errorf("himmelblau shouldn't be defined for 3 variables")
+	}
+	if err := GetBenchmark("hiff").CheckDim(12); err == nil {
+		t.Errorf("hiff shouldn't be defined for 12 bits")
+	}
+	if err := GetBenchmark("wfg2").CheckDim(7); err == nil {
+		t.Errorf("wfg2 shouldn't be defined for an odd number of distance parameters")
+	}
+	if err := GetBenchmark("sphere").CheckDim(100); err != nil {
+		t.Errorf("sphere should be defined for 100 variables: %v", err)
+	}
+	b := GetBenchmark("zdt4")
+	low, up := b.GetBounds(3)
+	if low[0] != 0.0 || up[0] != 1.0 || low[2] != -5.0 || up[2] != 5.0 {
+		t.Errorf("Wrong bounds of zdt4: %v, %v", low, up)
+	}
+	if err := GetBenchmark("royal_road1").CheckDim(12); err == nil {
+		t.Errorf("royal_road1 shouldn't be defined for 12 bits")
+	}
+	if v := GetBenchmark("royal_road2").GetOptimum(32); v[0] != 96.0 {
+		t.Errorf("the optimum of royal_road2 for 32 bits should be 96: %v", v)
+	}
+	if b := GetBenchmark("cf8"); b.Objectives() != 3 {
+		t.Errorf("cf8 should have 3 objectives: %v", b.Objectives())
+	}
+	// the constrained problems are registered as benchmarks of their objectives
+	for _, name := range ConstrainedProblemNames() {
+		cp, b := GetConstrainedProblem(name), GetBenchmark(name)
+		if min, max := b.GetDims(); min != cp.Dims() || max != cp.Dims() {
+			t.Errorf("%s should be defined for %d variables: [%d, %d]", name, cp.Dims(), min, max)
+		}
+		if v := b.GetOptimum(cp.Dims()); v[0] != cp.GetOptimum() {
+			t.Errorf("%s: the optimum should be %v: %v", name, cp.GetOptimum(), v)
+		}
+	}
+	if !GetBenchmark("h1").IsMaximization() || GetBenchmark("sphere").IsMaximization() {
+		t.Errorf("Wrong directions of the benchmarks")
+	}
+	// the random instances are built once for each dimension
+	x := GetBenchmark("maxsat").Sample(20)
+	if v1, v2 := GetBenchmark("maxsat").Evaluate(x), GetBenchmark("maxsat").Evaluate(x); v1[0] != v2[0] {
+		t.Errorf("The instance of maxsat should be the same for 20 variables: %v, %v", v1, v2)
+	}
+	// all the items are packed, the repaired knapsacks keep part of them
+	if v := GetBenchmark("knapsack2").Evaluate(GetBenchmark("trap").GetOptimizer(30)); len(v) != 2 || v[0] <= 0.0 || v[1] <= 0.0 {
+		t.Errorf("The repaired knapsacks should have the positive profits: %v", v)
+	}
+	if err := GetBenchmark("multiplexer").CheckDim(11); err != nil {
+		t.Errorf("multiplexer should be defined for 11 bits: %v", err)
+	}
+	if err := GetBenchmark("multiplexer").CheckDim(12); err == nil {
+		t.Errorf("multiplexer shouldn't be defined for 12 bits")
+	}
+	if b := GetBenchmark("nguyen-9"); b.IsMaximization() || b.CheckDim(2) != nil || b.CheckDim(3) == nil {
+		t.Errorf("nguyen-9 should be minimized for 2 variables")
+	}
+	if _, ok := LookupBenchmark("unknown"); ok {
+		t.Errorf("The unknown benchmark shouldn't be registered")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("GetBenchmark should panic for the unknown benchmark")
+		}
+	}()
+	GetBenchmark("unknown")
+}
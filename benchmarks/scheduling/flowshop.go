@@ -0,0 +1,234 @@
+// Package scheduling provides the permutation flow-shop and the job-shop scheduling problems over base.IntIndividual.
+//
+// The jobs and the machines are indexed from 0. A solution of the flow-shop problem is a permutation of the jobs,
+// and a solution of the job-shop problem is the operation-based encoding, a sequence where each job appears once per machine.
+// The instances can be generated or parsed in the formats of Taillard: http://mistic.heig-vd.ch/taillard/problemes.dir/ordonnancement.dir/ordonnancement.html
+package scheduling
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+)
+
+// FlowShop is a permutation flow-shop problem, all jobs are processed by the machines in the same order and each machine processes the jobs in the same order
+type FlowShop struct {
+	name       string
+	times      [][]int // times[i][j] is the processing time of the job j on the machine i
+	dueDates   []int
+	upperBound int
+	lowerBound int
+}
+
+// NewFlowShop returns *FlowShop named name, times[i][j] is the processing time of the job j on the machine i. The bounds of the makespan are unknown.
+func NewFlowShop(name string, times [][]int) *FlowShop {
+	if len(times) == 0 || len(times[0]) == 0 {
+		panic("The flow-shop problem should have at least one machine and one job")
+	}
+	for i, row := range times {
+		if len(row) != len(times[0]) {
+			panic(fmt.Sprintf("The machine %d should have the processing times of %d jobs: %v", i, len(times[0]), len(row)))
+		}
+	}
+	return &FlowShop{name: name, times: times, upperBound: -1, lowerBound: -1}
+}
+
+// GetName returns the name of the problem
+func (fs *FlowShop) GetName() string {
+	return fs.name
+}
+
+// Jobs returns the number of jobs
+func (fs *FlowShop) Jobs() int {
+	return len(fs.times[0])
+}
+
+// Machines returns the number of machines
+func (fs *FlowShop) Machines() int {
+	return len(fs.times)
+}
+
+// ProcessingTime returns the processing time of job on machine
+func (fs *FlowShop) ProcessingTime(machine, job int) int {
+	return fs.times[machine][job]
+}
+
+// GetUpperBound returns the best known makespan, -1 means unknown
+func (fs *FlowShop) GetUpperBound() int {
+	return fs.upperBound
+}
+
+// GetLowerBound returns the known lower bound of the makespan, -1 means unknown
+func (fs *FlowShop) GetLowerBound() int {
+	return fs.lowerBound
+}
+
+// SetBounds sets the best known makespan and the lower bound of the makespan
+func (fs *FlowShop) SetBounds(upper, lower int) {
+	fs.upperBound, fs.lowerBound = upper, lower
+}
+
+// SetDueDates sets the due dates of the jobs for the tardiness
+func (fs *FlowShop) SetDueDates(dueDates []int) {
+	if len(dueDates) != fs.Jobs() {
+		panic(fmt.Sprintf("The due dates should be given for %d jobs: %v", fs.Jobs(), len(dueDates)))
+	}
+	fs.dueDates = dueDates
+}
+
+// GetDueDates returns the due dates of the jobs, which is nil if they aren't set
+func (fs *FlowShop) GetDueDates() []int {
+	return fs.dueDates
+}
+
+// SetDueDatesByFactor sets the due date of each job to factor times its total processing time
+func (fs *FlowShop) SetDueDatesByFactor(factor float64) {
+	dueDates := make([]int, fs.Jobs())
+	for j := range dueDates {
+		total := 0
+		for i := range fs.times {
+			total += fs.times[i][j]
+		}
+		dueDates[j] = int(math.Round(factor * float64(total)))
+	}
+	fs.dueDates = dueDates
+}
+
+// CompletionTimes returns the completion times of the jobs on the last machine processed in the order of the permutation sequence
+func (fs *FlowShop) CompletionTimes(sequence []int) []int {
+	if len(sequence) != fs.Jobs() {
+		panic(fmt.Sprintf("The sequence should be a permutation of %d jobs: %v", fs.Jobs(), sequence))
+	}
+	// ready[i] is the time when the machine i finishes the last scheduled job
+	ready := make([]int, fs.Machines())
+	completions := make([]int, fs.Jobs())
+	for _, job := range sequence {
+		t := 0
+		for i := range fs.times {
+			if ready[i] > t {
+				t = ready[i]
+			}
+			t += fs.times[i][job]
+			ready[i] = t
+		}
+		completions[job] = t
+	}
+	return completions
+}
+
+// Makespan returns the completion time of the last job
+func (fs *FlowShop) Makespan(sequence []int) int {
+	return maxInt(fs.CompletionTimes(sequence))
+}
+
+// TotalFlowTime returns the sum of the completion times of the jobs
+func (fs *FlowShop) TotalFlowTime(sequence []int) int {
+	return sumInt(fs.CompletionTimes(sequence))
+}
+
+// TotalTardiness returns the sum of the tardiness max(0, completion - due date) of the jobs, the due dates must be set
+func (fs *FlowShop) TotalTardiness(sequence []int) int {
+	return tardiness(fs.CompletionTimes(sequence), fs.dueDates)
+}
+
+// Evaluate is the benchmarks.IntEvaluator of the makespan which should be minimized
+func (fs *FlowShop) Evaluate(individual *base.IntIndividual) []float64 {
+	return []float64{float64(fs.Makespan(individual.GetChromosome().([]int)))}
+}
+
+// EvaluateFlowTime is the benchmarks.IntEvaluator of the total flow time which should be minimized
+func (fs *FlowShop) EvaluateFlowTime(individual *base.IntIndividual) []float64 {
+	return []float64{float64(fs.TotalFlowTime(individual.GetChromosome().([]int)))}
+}
+
+// EvaluateTardiness is the benchmarks.IntEvaluator of the total tardiness which should be minimized
+func (fs *FlowShop) EvaluateTardiness(individual *base.IntIndividual) []float64 {
+	return []float64{float64(fs.TotalTardiness(individual.GetChromosome().([]int)))}
+}
+
+// TaillardLowerBound returns the lower bound of the makespan of Taillard, which is the maximum of the total processing times of the jobs
+// and the loads of the machines with the shortest heads and tails
+func (fs *FlowShop) TaillardLowerBound() int {
+	n, m := fs.Jobs(), fs.Machines()
+	bound := 0
+	for j := 0; j < n; j++ {
+		total := 0
+		for i := 0; i < m; i++ {
+			total += fs.times[i][j]
+		}
+		if total > bound {
+			bound = total
+		}
+	}
+	for i := 0; i < m; i++ {
+		load, head, tail := 0, math.MaxInt32, math.MaxInt32
+		for j := 0; j < n; j++ {
+			load += fs.times[i][j]
+			b, a := 0, 0
+			for k := 0; k < i; k++ {
+				b += fs.times[k][j]
+			}
+			for k := i + 1; k < m; k++ {
+				a += fs.times[k][j]
+			}
+			if b < head {
+				head = b
+			}
+			if a < tail {
+				tail = a
+			}
+		}
+		if v := head + load + tail; v > bound {
+			bound = v
+		}
+	}
+	return bound
+}
+
+// RandomSequence returns a random permutation of the jobs
+func (fs *FlowShop) RandomSequence() []int {
+	return rand.Perm(fs.Jobs())
+}
+
+// NewIndividual returns *base.IntIndividual of a random sequence with fitness
+func (fs *FlowShop) NewIndividual(fitness *base.Fitness) *base.IntIndividual {
+	return base.NewIntIndividual(fs.RandomSequence(), fitness)
+}
+
+func (fs *FlowShop) String() string {
+	return fmt.Sprintf("%s (%d jobs, %d machines)", fs.name, fs.Jobs(), fs.Machines())
+}
+
+func maxInt(values []int) int {
+	ans := 0
+	for _, v := range values {
+		if v > ans {
+			ans = v
+		}
+	}
+	return ans
+}
+
+func sumInt(values []int) int {
+	ans := 0
+	for _, v := range values {
+		ans += v
+	}
+	return ans
+}
+
+// tardiness returns the total tardiness of the completion times with the due dates
+func tardiness(completions, dueDates []int) int {
+	if dueDates == nil {
+		panic("The due dates aren't set")
+	}
+	ans := 0
+	for j, c := range completions {
+		if c > dueDates[j] {
+			ans += c - dueDates[j]
+		}
+	}
+	return ans
+}
@@ -0,0 +1,100 @@
+package scheduling
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sineatos/deag/benchmarks"
+)
+
+// taillardFlowShops are the seeds and the bounds of the bundled Taillard flow-shop instances of 20 jobs and 5 machines, whose upper bounds are optimal
+var taillardFlowShops = map[string][3]int64{
+	"ta001": {873654221, 1278, 1232},
+	"ta002": {379008056, 1359, 1290},
+	"ta003": {1866992158, 1081, 1073},
+	"ta004": {216771124, 1293, 1268},
+	"ta005": {495070989, 1235, 1198},
+	"ta006": {402959317, 1195, 1180},
+	"ta007": {1369363414, 1234, 1226},
+	"ta008": {2021925980, 1206, 1170},
+	"ta009": {573109518, 1230, 1206},
+	"ta010": {88325120, 1108, 1082},
+}
+
+// ft06 is the job-shop instance of Fisher and Thompson of 6 jobs and 6 machines, whose optimal makespan is 55
+const ft06 = `# Fisher and Thompson 6x6 instance, alternate name (mt06)
+6 6
+2 1 0 3 1 6 3 7 5 3 4 6
+1 8 2 5 4 10 5 10 0 10 3 4
+2 5 3 4 5 8 0 9 1 1 4 7
+1 5 0 5 2 5 3 3 4 8 5 9
+2 9 1 3 4 5 5 4 0 3 3 1
+1 3 3 3 5 9 0 10 4 4 2 1
+`
+
+// BundledFlowShop returns *FlowShop of the bundled instance named name with the known bounds, it panics if the instance isn't bundled
+func BundledFlowShop(name string) *FlowShop {
+	ta, ok := taillardFlowShops[name]
+	if !ok {
+		panic(fmt.Sprintf("The flow-shop instance isn't bundled: %s", name))
+	}
+	fs := GenerateTaillardFlowShop(name, 20, 5, ta[0])
+	fs.SetBounds(int(ta[1]), int(ta[2]))
+	return fs
+}
+
+// FlowShopNames returns the sorted names of the bundled flow-shop instances
+func FlowShopNames() []string {
+	names := make([]string, 0, len(taillardFlowShops))
+	for name := range taillardFlowShops {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// BundledJobShop returns *JobShop of the bundled instance named name with the known bounds, it panics if the instance isn't bundled
+func BundledJobShop(name string) *JobShop {
+	switch name {
+	case "ft06":
+		js, err := ParseJobShop(name, strings.NewReader(ft06))
+		if err != nil {
+			panic(fmt.Sprintf("The bundled job-shop instance %s is invalid: %v", name, err))
+		}
+		js.SetBounds(55, 55)
+		return js
+	case "ta01":
+		js := GenerateTaillardJobShop(name, 15, 15, 840612802, 398197754)
+		js.SetBounds(1231, 1005)
+		return js
+	}
+	panic(fmt.Sprintf("The job-shop instance isn't bundled: %s", name))
+}
+
+// JobShopNames returns the sorted names of the bundled job-shop instances
+func JobShopNames() []string {
+	return []string{"ft06", "ta01"}
+}
+
+// init registers the bundled instances as the makespan benchmarks "flowshop_" + name and "jobshop_" + name, such as "flowshop_ta001" and "jobshop_ft06".
+// The chromosomes are the permutations of the jobs and the operation-based encodings, the optimum is known if the upper bound is optimal.
+func init() {
+	for _, name := range FlowShopNames() {
+		fs := BundledFlowShop(name)
+		optimum := float64(fs.GetUpperBound())
+		benchmarks.RegisterBenchmark(benchmarks.NewBenchmark("flowshop_"+name, benchmarks.IntEvaluator(fs.Evaluate), 1, false).
+			SetDims(fs.Jobs(), fs.Jobs(), nil).SetOptimum(func(dim int) []float64 { return []float64{optimum} }, nil))
+	}
+	for _, name := range JobShopNames() {
+		js := BundledJobShop(name)
+		dim := js.Jobs() * js.Machines()
+		b := benchmarks.NewBenchmark("jobshop_"+name, benchmarks.IntEvaluator(js.Evaluate), 1, false).SetDims(dim, dim, nil).
+			SetSampler(func(dim int) interface{} { return js.RandomSequence() })
+		if js.GetUpperBound() == js.GetLowerBound() {
+			optimum := float64(js.GetUpperBound())
+			b.SetOptimum(func(dim int) []float64 { return []float64{optimum} }, nil)
+		}
+		benchmarks.RegisterBenchmark(b)
+	}
+}
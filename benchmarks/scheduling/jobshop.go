@@ -0,0 +1,213 @@
+package scheduling
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+)
+
+// JobShop is a job-shop problem, each job is processed by all machines in its own order and each machine processes one operation at a time.
+//
+// A solution is the operation-based encoding of length jobs*machines, where the k-th occurrence of the job j is its k-th operation.
+// It is decoded into the semi-active schedule, each operation starts as early as its job and its machine are ready.
+type JobShop struct {
+	name       string
+	machines   [][]int // machines[j][k] is the machine of the k-th operation of the job j
+	times      [][]int // times[j][k] is the processing time of the k-th operation of the job j
+	dueDates   []int
+	upperBound int
+	lowerBound int
+}
+
+// NewJobShop returns *JobShop named name, machines[j][k] and times[j][k] are the machine and the processing time of the k-th operation of the job j.
+// The bounds of the makespan are unknown.
+func NewJobShop(name string, machines, times [][]int) *JobShop {
+	if err := checkJobShop(machines, times); err != nil {
+		panic(err.Error())
+	}
+	return &JobShop{name: name, machines: machines, times: times, upperBound: -1, lowerBound: -1}
+}
+
+// checkJobShop returns the error if the jobs don't have the same number of operations or a job doesn't visit each machine once
+func checkJobShop(machines, times [][]int) error {
+	if len(machines) == 0 || len(machines) != len(times) {
+		return fmt.Errorf("The machines and the times should be given for the same jobs: %v, %v", len(machines), len(times))
+	}
+	m := len(machines[0])
+	for j := range machines {
+		if len(machines[j]) != m || len(times[j]) != m {
+			return fmt.Errorf("The job %d should have %d operations", j, m)
+		}
+		visited := make([]bool, m)
+		for _, machine := range machines[j] {
+			if machine < 0 || machine >= m || visited[machine] {
+				return fmt.Errorf("The job %d should visit each of %d machines once: %v", j, m, machines[j])
+			}
+			visited[machine] = true
+		}
+	}
+	return nil
+}
+
+// GetName returns the name of the problem
+func (js *JobShop) GetName() string {
+	return js.name
+}
+
+// Jobs returns the number of jobs
+func (js *JobShop) Jobs() int {
+	return len(js.machines)
+}
+
+// Machines returns the number of machines, which is the number of operations of each job
+func (js *JobShop) Machines() int {
+	return len(js.machines[0])
+}
+
+// Operation returns the machine and the processing time of the k-th operation of job
+func (js *JobShop) Operation(job, k int) (int, int) {
+	return js.machines[job][k], js.times[job][k]
+}
+
+// GetUpperBound returns the best known makespan, -1 means unknown
+func (js *JobShop) GetUpperBound() int {
+	return js.upperBound
+}
+
+// GetLowerBound returns the known lower bound of the makespan, -1 means unknown
+func (js *JobShop) GetLowerBound() int {
+	return js.lowerBound
+}
+
+// SetBounds sets the best known makespan and the lower bound of the makespan
+func (js *JobShop) SetBounds(upper, lower int) {
+	js.upperBound, js.lowerBound = upper, lower
+}
+
+// SetDueDates sets the due dates of the jobs for the tardiness
+func (js *JobShop) SetDueDates(dueDates []int) {
+	if len(dueDates) != js.Jobs() {
+		panic(fmt.Sprintf("The due dates should be given for %d jobs: %v", js.Jobs(), len(dueDates)))
+	}
+	js.dueDates = dueDates
+}
+
+// GetDueDates returns the due dates of the jobs, which is nil if they aren't set
+func (js *JobShop) GetDueDates() []int {
+	return js.dueDates
+}
+
+// SetDueDatesByFactor sets the due date of each job to factor times its total processing time
+func (js *JobShop) SetDueDatesByFactor(factor float64) {
+	dueDates := make([]int, js.Jobs())
+	for j := range dueDates {
+		dueDates[j] = int(math.Round(factor * float64(sumInt(js.times[j]))))
+	}
+	js.dueDates = dueDates
+}
+
+// IsSequence returns if sequence is a valid operation-based encoding
+func (js *JobShop) IsSequence(sequence []int) bool {
+	if len(sequence) != js.Jobs()*js.Machines() {
+		return false
+	}
+	counts := make([]int, js.Jobs())
+	for _, job := range sequence {
+		if job < 0 || job >= js.Jobs() || counts[job] == js.Machines() {
+			return false
+		}
+		counts[job]++
+	}
+	return true
+}
+
+// Schedule returns the start times of the operations of the semi-active schedule decoded from sequence, starts[j][k] is the start time of the k-th operation of the job j
+func (js *JobShop) Schedule(sequence []int) [][]int {
+	if !js.IsSequence(sequence) {
+		panic(fmt.Sprintf("Invalid operation-based encoding of %d jobs and %d machines: %v", js.Jobs(), js.Machines(), sequence))
+	}
+	starts := make([][]int, js.Jobs())
+	for j := range starts {
+		starts[j] = make([]int, js.Machines())
+	}
+	next, jobReady, machineReady := make([]int, js.Jobs()), make([]int, js.Jobs()), make([]int, js.Machines())
+	for _, job := range sequence {
+		k := next[job]
+		machine := js.machines[job][k]
+		start := jobReady[job]
+		if machineReady[machine] > start {
+			start = machineReady[machine]
+		}
+		starts[job][k] = start
+		jobReady[job] = start + js.times[job][k]
+		machineReady[machine] = jobReady[job]
+		next[job]++
+	}
+	return starts
+}
+
+// CompletionTimes returns the completion times of the jobs decoded from sequence
+func (js *JobShop) CompletionTimes(sequence []int) []int {
+	starts := js.Schedule(sequence)
+	last := js.Machines() - 1
+	completions := make([]int, js.Jobs())
+	for j := range completions {
+		completions[j] = starts[j][last] + js.times[j][last]
+	}
+	return completions
+}
+
+// Makespan returns the completion time of the last job
+func (js *JobShop) Makespan(sequence []int) int {
+	return maxInt(js.CompletionTimes(sequence))
+}
+
+// TotalFlowTime returns the sum of the completion times of the jobs
+func (js *JobShop) TotalFlowTime(sequence []int) int {
+	return sumInt(js.CompletionTimes(sequence))
+}
+
+// TotalTardiness returns the sum of the tardiness max(0, completion - due date) of the jobs, the due dates must be set
+func (js *JobShop) TotalTardiness(sequence []int) int {
+	return tardiness(js.CompletionTimes(sequence), js.dueDates)
+}
+
+// Evaluate is the benchmarks.IntEvaluator of the makespan which should be minimized
+func (js *JobShop) Evaluate(individual *base.IntIndividual) []float64 {
+	return []float64{float64(js.Makespan(individual.GetChromosome().([]int)))}
+}
+
+// EvaluateFlowTime is the benchmarks.IntEvaluator of the total flow time which should be minimized
+func (js *JobShop) EvaluateFlowTime(individual *base.IntIndividual) []float64 {
+	return []float64{float64(js.TotalFlowTime(individual.GetChromosome().([]int)))}
+}
+
+// EvaluateTardiness is the benchmarks.IntEvaluator of the total tardiness which should be minimized
+func (js *JobShop) EvaluateTardiness(individual *base.IntIndividual) []float64 {
+	return []float64{float64(js.TotalTardiness(individual.GetChromosome().([]int)))}
+}
+
+// RandomSequence returns a random operation-based encoding
+func (js *JobShop) RandomSequence() []int {
+	sequence := make([]int, 0, js.Jobs()*js.Machines())
+	for j := 0; j < js.Jobs(); j++ {
+		for k := 0; k < js.Machines(); k++ {
+			sequence = append(sequence, j)
+		}
+	}
+	rand.Shuffle(len(sequence), func(a, b int) {
+		sequence[a], sequence[b] = sequence[b], sequence[a]
+	})
+	return sequence
+}
+
+// NewIndividual returns *base.IntIndividual of a random sequence with fitness
+func (js *JobShop) NewIndividual(fitness *base.Fitness) *base.IntIndividual {
+	return base.NewIntIndividual(js.RandomSequence(), fitness)
+}
+
+func (js *JobShop) String() string {
+	return fmt.Sprintf("%s (%d jobs, %d machines)", js.name, js.Jobs(), js.Machines())
+}
@@ -0,0 +1,205 @@
+package scheduling
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+)
+
+func TestTaillardFlowShop(t *testing.T) {
+	fs := BundledFlowShop("ta001")
+	if fs.Jobs() != 20 || fs.Machines() != 5 {
+		t.Fatalf("ta001 should have 20 jobs and 5 machines: %v", fs)
+	}
+	for j, v := range []int{54, 83, 15, 71, 77} {
+		if fs.ProcessingTime(0, j) != v {
+			t.Errorf("ta001: the processing time of the job %d on the machine 0 should be %d: %d", j, v, fs.ProcessingTime(0, j))
+		}
+	}
+	for _, name := range FlowShopNames() {
+		fs := BundledFlowShop(name)
+		if lb := fs.TaillardLowerBound(); lb != fs.GetLowerBound() {
+			t.Errorf("%s: the lower bound should be %d: %d", name, fs.GetLowerBound(), lb)
+		}
+		for i := 0; i < 10; i++ {
+			if ms := fs.Makespan(fs.RandomSequence()); ms < fs.GetUpperBound() {
+				t.Errorf("%s: the makespan %d is less than the optimum %d", name, ms, fs.GetUpperBound())
+			}
+		}
+	}
+}
+
+func TestFlowShop(t *testing.T) {
+	// the jobs take 1, 2 on the machine 0 and 3, 1 on the machine 1
+	fs := NewFlowShop("test", [][]int{{1, 2}, {3, 1}})
+	if c := fs.CompletionTimes([]int{0, 1}); c[0] != 4 || c[1] != 5 {
+		t.Errorf("The completion times of [0 1] should be [4 5]: %v", c)
+	}
+	if c := fs.CompletionTimes([]int{1, 0}); c[1] != 3 || c[0] != 6 {
+		t.Errorf("The completion times of [1 0] should be [6 3]: %v", c)
+	}
+	if v := fs.TotalFlowTime([]int{0, 1}); v != 9 {
+		t.Errorf("The total flow time of [0 1] should be 9: %d", v)
+	}
+	fs.SetDueDates([]int{3, 6})
+	if v := fs.TotalTardiness([]int{1, 0}); v != 3 {
+		t.Errorf("The total tardiness of [1 0] should be 3: %d", v)
+	}
+	ind := base.NewIntIndividual([]int{0, 1}, base.NewFitness([]float64{-1.0}))
+	if v := fs.Evaluate(ind); v[0] != 5.0 {
+		t.Errorf("The makespan of [0 1] should be 5: %v", v)
+	}
+}
+
+func TestParseTaillardFlowShop(t *testing.T) {
+	text := `number of jobs, number of machines, initial seed, upper bound and lower bound :
+          20           5   873654221        1278        1232
+processing times :
+`
+	fs := BundledFlowShop("ta001")
+	var b strings.Builder
+	b.WriteString(text)
+	for i := 0; i < fs.Machines(); i++ {
+		for j := 0; j < fs.Jobs(); j++ {
+			b.WriteString(" " + strconv.Itoa(fs.ProcessingTime(i, j)))
+		}
+		b.WriteString("\n")
+	}
+	problems, err := ParseTaillardFlowShop(strings.NewReader(b.String() + b.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) != 2 {
+		t.Fatalf("There should be 2 instances: %d", len(problems))
+	}
+	p := problems[1]
+	if p.GetName() != "873654221" || p.GetUpperBound() != 1278 || p.GetLowerBound() != 1232 {
+		t.Errorf("Wrong instance: %v %d %d", p, p.GetUpperBound(), p.GetLowerBound())
+	}
+	sequence := fs.RandomSequence()
+	if p.Makespan(sequence) != fs.Makespan(sequence) {
+		t.Errorf("The parsed instance should be the same as ta001")
+	}
+	if _, err := ParseTaillardFlowShop(strings.NewReader(text + " 1 2 3\n")); err == nil {
+		t.Errorf("The instance without enough processing times should be invalid")
+	}
+}
+
+func TestJobShop(t *testing.T) {
+	js := BundledJobShop("ft06")
+	if js.Jobs() != 6 || js.Machines() != 6 {
+		t.Fatalf("ft06 should have 6 jobs and 6 machines: %v", js)
+	}
+	for i := 0; i < 20; i++ {
+		sequence := js.RandomSequence()
+		if !js.IsSequence(sequence) {
+			t.Fatalf("Invalid random sequence: %v", sequence)
+		}
+		starts := js.Schedule(sequence)
+		// the operations of a job and the operations on a machine shouldn't overlap
+		for j := 0; j < js.Jobs(); j++ {
+			for k := 0; k < js.Machines(); k++ {
+				m1, p1 := js.Operation(j, k)
+				if k > 0 {
+					_, p0 := js.Operation(j, k-1)
+					if starts[j][k] < starts[j][k-1]+p0 {
+						t.Fatalf("The operation %d of the job %d starts before its previous operation finishes", k, j)
+					}
+				}
+				for j2 := 0; j2 < js.Jobs(); j2++ {
+					for k2 := 0; k2 < js.Machines(); k2++ {
+						m2, p2 := js.Operation(j2, k2)
+						if (j2 != j || k2 != k) && m1 == m2 && starts[j][k] < starts[j2][k2]+p2 && starts[j2][k2] < starts[j][k]+p1 {
+							t.Fatalf("The operations (%d, %d) and (%d, %d) overlap on the machine %d", j, k, j2, k2, m1)
+						}
+					}
+				}
+			}
+		}
+		if ms := js.Makespan(sequence); ms < js.GetUpperBound() {
+			t.Errorf("ft06: the makespan %d is less than the optimum %d", ms, js.GetUpperBound())
+		}
+	}
+	if js.IsSequence([]int{0, 1, 2}) {
+		t.Errorf("The short sequence should be invalid")
+	}
+	ta01 := BundledJobShop("ta01")
+	for k, v := range []int{6, 12, 4, 7, 3, 2, 10, 11, 8, 14, 9, 13, 5, 0, 1} {
+		if m, _ := ta01.Operation(0, k); m != v {
+			t.Errorf("ta01: the machine of the operation %d of the job 0 should be %d: %d", k, v, m)
+		}
+	}
+	if _, p := ta01.Operation(0, 0); p != 94 {
+		t.Errorf("ta01: the processing time of the first operation should be 94: %d", p)
+	}
+}
+
+func TestParseJobShop(t *testing.T) {
+	js, err := ParseJobShop("test", strings.NewReader("# comment\n2 2\n0 3 1 2\n1 4 0 1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the job 0 runs on 0 at [0, 3), then the job 1 on 1 at [0, 4), the job 0 on 1 at [4, 6) and the job 1 on 0 at [4, 5)
+	if c := js.CompletionTimes([]int{0, 1, 0, 1}); c[0] != 6 || c[1] != 5 {
+		t.Errorf("The completion times should be [6 5]: %v", c)
+	}
+	js.SetDueDatesByFactor(1.0)
+	if v := js.TotalTardiness([]int{0, 1, 0, 1}); v != 1 {
+		t.Errorf("The total tardiness should be 1: %d", v)
+	}
+	if _, err := ParseJobShop("test", strings.NewReader("2 2\n0 3 0 2\n1 4 0 1\n")); err == nil {
+		t.Errorf("The job visiting a machine twice should be invalid")
+	}
+	text := `Nb of jobs, Nb of Machines, Time seed, Machine seed, Upper bound, Lower bound :
+ 2 2 1 2 6 5
+Times
+ 3 2
+ 4 1
+Machines
+ 1 2
+ 2 1
+`
+	problems, err := ParseTaillardJobShop(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) != 1 || problems[0].GetUpperBound() != 6 || problems[0].GetLowerBound() != 5 {
+		t.Fatalf("Wrong instances: %v", problems)
+	}
+	if problems[0].Makespan([]int{0, 1, 0, 1}) != js.Makespan([]int{0, 1, 0, 1}) {
+		t.Errorf("The Taillard instance should be the same as the OR-Library instance")
+	}
+}
+
+func TestRegisteredBenchmarks(t *testing.T) {
+	for _, name := range FlowShopNames() {
+		fs, b := BundledFlowShop(name), benchmarks.GetBenchmark("flowshop_"+name)
+		sequence := b.Sample(fs.Jobs()).([]int)
+		if v := b.Evaluate(sequence); len(v) != 1 || v[0] != float64(fs.Makespan(sequence)) {
+			t.Errorf("flowshop_%s: the value should be the makespan %d: %v", name, fs.Makespan(sequence), v)
+		}
+		if v := b.GetOptimum(fs.Jobs()); v[0] != float64(fs.GetUpperBound()) {
+			t.Errorf("flowshop_%s: the optimum should be %d: %v", name, fs.GetUpperBound(), v)
+		}
+	}
+	for _, name := range JobShopNames() {
+		js, b := BundledJobShop(name), benchmarks.GetBenchmark("jobshop_"+name)
+		dim := js.Jobs() * js.Machines()
+		sequence := b.Sample(dim).([]int)
+		if !js.IsSequence(sequence) {
+			t.Errorf("jobshop_%s: the random chromosome should be an operation-based encoding: %v", name, sequence)
+		}
+		if v := b.Evaluate(sequence); len(v) != 1 || v[0] != float64(js.Makespan(sequence)) {
+			t.Errorf("jobshop_%s: the value should be the makespan %d: %v", name, js.Makespan(sequence), v)
+		}
+	}
+	if v := benchmarks.GetBenchmark("jobshop_ft06").GetOptimum(36); v[0] != 55.0 {
+		t.Errorf("The optimum of jobshop_ft06 should be 55: %v", v)
+	}
+	if benchmarks.GetBenchmark("jobshop_ta01").HasOptimum() {
+		t.Errorf("The optimum of jobshop_ta01 isn't known by its bounds")
+	}
+}
@@ -0,0 +1,259 @@
+package scheduling
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// taillardUnif returns a random integer in [low, high] by the generator of Taillard and updates seed
+//
+// From: E. Taillard. Benchmarks for basic scheduling problems. European Journal of Operational Research, vol. 64, no. 2, p. 278 - 285, 1993.
+func taillardUnif(seed *int64, low, high int) int {
+	const m, a, b, c = 2147483647, 16807, 127773, 2836
+	k := *seed / b
+	*seed = a*(*seed%b) - k*c
+	if *seed < 0 {
+		*seed += m
+	}
+	return low + int(float64(*seed)/float64(m)*float64(high-low+1))
+}
+
+// GenerateTaillardFlowShop returns *FlowShop generated by the generator of Taillard from seed, the processing times are in [1, 99]
+func GenerateTaillardFlowShop(name string, jobs, machines int, seed int64) *FlowShop {
+	times := make([][]int, machines)
+	for i := range times {
+		times[i] = make([]int, jobs)
+		for j := range times[i] {
+			times[i][j] = taillardUnif(&seed, 1, 99)
+		}
+	}
+	return NewFlowShop(name, times)
+}
+
+// GenerateTaillardJobShop returns *JobShop generated by the generator of Taillard from timeSeed and machineSeed, the processing times are in [1, 99]
+func GenerateTaillardJobShop(name string, jobs, machines int, timeSeed, machineSeed int64) *JobShop {
+	times, orders := make([][]int, jobs), make([][]int, jobs)
+	for j := range times {
+		times[j] = make([]int, machines)
+		for k := range times[j] {
+			times[j][k] = taillardUnif(&timeSeed, 1, 99)
+		}
+	}
+	for j := range orders {
+		orders[j] = make([]int, machines)
+		for k := range orders[j] {
+			orders[j][k] = k
+		}
+		for k := range orders[j] {
+			swap := taillardUnif(&machineSeed, k, machines-1)
+			orders[j][k], orders[j][swap] = orders[j][swap], orders[j][k]
+		}
+	}
+	return NewJobShop(name, orders, times)
+}
+
+// LoadTaillardFlowShop returns the flow-shop problems parsed from the Taillard file of path
+func LoadTaillardFlowShop(path string) ([]*FlowShop, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ParseTaillardFlowShop(file)
+}
+
+// ParseTaillardFlowShop returns the flow-shop problems parsed from the Taillard text read from r, such as
+//
+//	number of jobs, number of machines, initial seed, upper bound and lower bound :
+//	          20           5   873654221        1278        1232
+//	processing times :
+//	 54 83 15 71 77 36 53 38 27 87 76 91 14 29 12 77 32 87 68 94
+//	 ...
+//
+// where each row is a machine. The problems are named by their seeds.
+func ParseTaillardFlowShop(r io.Reader) ([]*FlowShop, error) {
+	blocks, err := taillardBlocks(r, []string{"processing times"})
+	if err != nil {
+		return nil, err
+	}
+	problems := make([]*FlowShop, len(blocks))
+	for p, b := range blocks {
+		jobs, machines := b.header[0], b.header[1]
+		times := make([][]int, machines)
+		for i := range times {
+			times[i] = b.data[0][i*jobs : (i+1)*jobs]
+		}
+		problems[p] = NewFlowShop(strconv.Itoa(b.header[2]), times)
+		problems[p].SetBounds(b.header[3], b.header[4])
+	}
+	return problems, nil
+}
+
+// LoadTaillardJobShop returns the job-shop problems parsed from the Taillard file of path
+func LoadTaillardJobShop(path string) ([]*JobShop, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ParseTaillardJobShop(file)
+}
+
+// ParseTaillardJobShop returns the job-shop problems parsed from the Taillard text read from r, such as
+//
+//	Nb of jobs, Nb of Machines, Time seed, Machine seed, Upper bound, Lower bound :
+//	          15          15   840612802   398197754        1231        1005
+//	Times
+//	 94 66 10 53 26 15 65 82 10 27 93 92 96 70 83
+//	 ...
+//	Machines
+//	 7 13  5  8  4  3 11 12  9 15 10 14  6  1  2
+//	 ...
+//
+// where each row is a job and the machines are indexed from 1. The problems are named by their time seeds.
+func ParseTaillardJobShop(r io.Reader) ([]*JobShop, error) {
+	blocks, err := taillardBlocks(r, []string{"times", "machines"})
+	if err != nil {
+		return nil, err
+	}
+	problems := make([]*JobShop, len(blocks))
+	for p, b := range blocks {
+		jobs, machines := b.header[0], b.header[1]
+		times, orders := make([][]int, jobs), make([][]int, jobs)
+		for j := range times {
+			times[j] = b.data[0][j*machines : (j+1)*machines]
+			orders[j] = b.data[1][j*machines : (j+1)*machines]
+			for k := range orders[j] {
+				orders[j][k]--
+			}
+		}
+		if problems[p], err = newJobShop(strconv.Itoa(b.header[2]), orders, times); err != nil {
+			return nil, err
+		}
+		problems[p].SetBounds(b.header[4], b.header[5])
+	}
+	return problems, nil
+}
+
+// ParseJobShop returns *JobShop named name parsed from the text read from r in the standard format of OR-Library, such as
+//
+//	6 6
+//	2 1 0 3 1 6 3 7 5 3 4 6
+//	...
+//
+// where the first line is the numbers of jobs and machines, each row is a job of pairs of the machine (indexed from 0) and the processing time.
+// The lines starting with # are comments.
+func ParseJobShop(name string, r io.Reader) (*JobShop, error) {
+	var numbers []int
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		text := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(text, "#") {
+			continue
+		}
+		for _, field := range strings.Fields(text) {
+			v, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number: %s", field)
+			}
+			numbers = append(numbers, v)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(numbers) < 2 || numbers[0] < 1 || numbers[1] < 1 || len(numbers) != 2+2*numbers[0]*numbers[1] {
+		return nil, fmt.Errorf("expected 2 + 2 * jobs * machines numbers, got %d", len(numbers))
+	}
+	jobs, machines := numbers[0], numbers[1]
+	times, orders := make([][]int, jobs), make([][]int, jobs)
+	for j := range times {
+		times[j], orders[j] = make([]int, machines), make([]int, machines)
+		for k := range times[j] {
+			orders[j][k], times[j][k] = numbers[2+2*(j*machines+k)], numbers[3+2*(j*machines+k)]
+		}
+	}
+	return newJobShop(name, orders, times)
+}
+
+// newJobShop returns *JobShop or the error if machines and times are invalid
+func newJobShop(name string, machines, times [][]int) (*JobShop, error) {
+	if err := checkJobShop(machines, times); err != nil {
+		return nil, err
+	}
+	return NewJobShop(name, machines, times), nil
+}
+
+// taillardBlock is an instance of the Taillard file, which is the numbers of the header and the numbers of the data sections
+type taillardBlock struct {
+	header []int
+	data   [][]int
+}
+
+// taillardBlocks returns the instances of the Taillard text, each instance is a header line of numbers after a line of text followed by the sections.
+// The first two numbers of the header are the numbers of jobs and machines, each section has jobs*machines numbers.
+func taillardBlocks(r io.Reader, sections []string) ([]*taillardBlock, error) {
+	var blocks []*taillardBlock
+	var current *taillardBlock
+	state := "" // "header", one of sections, or "" out of the instances
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		if c := text[0]; (c < '0' || c > '9') && c != '-' {
+			lower := strings.ToLower(strings.TrimRight(text, " :"))
+			state = "header"
+			for _, s := range sections {
+				if lower == s {
+					if current == nil || len(current.header) == 0 {
+						return nil, fmt.Errorf("line %d: the section %q is before the header", line, text)
+					}
+					state = s
+					current.data = append(current.data, nil)
+				}
+			}
+			if state == "header" {
+				current = &taillardBlock{}
+				blocks = append(blocks, current)
+			}
+			continue
+		}
+		if state == "" {
+			return nil, fmt.Errorf("line %d: numbers out of the instances: %s", line, text)
+		}
+		for _, field := range strings.Fields(text) {
+			v, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid number: %s", line, field)
+			}
+			if state == "header" {
+				current.header = append(current.header, v)
+			} else {
+				current.data[len(current.data)-1] = append(current.data[len(current.data)-1], v)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for _, b := range blocks {
+		if len(b.header) < len(sections)+4 || b.header[0] < 1 || b.header[1] < 1 {
+			return nil, fmt.Errorf("invalid header of the instance: %v", b.header)
+		}
+		if len(b.data) != len(sections) {
+			return nil, fmt.Errorf("the instance %v should have %d sections: %d", b.header, len(sections), len(b.data))
+		}
+		for _, d := range b.data {
+			if len(d) != b.header[0]*b.header[1] {
+				return nil, fmt.Errorf("the instance %v should have %d numbers in each section: %d", b.header, b.header[0]*b.header[1], len(d))
+			}
+		}
+	}
+	return blocks, nil
+}
@@ -10,6 +10,9 @@ import (
 // Float64Evaluator is the type of float64 evaluator
 type Float64Evaluator func(individual *base.Float64Individual) []float64
 
+// IntEvaluator is the type of int evaluator
+type IntEvaluator func(individual *base.IntIndividual) []float64
+
 //*************************************************************
 // Unimodal
 //*************************************************************
@@ -0,0 +1,116 @@
+package tsp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sineatos/deag/benchmarks"
+)
+
+// optima are the optimal tour lengths of the symmetric TSPLIB instances
+var optima = map[string]int{
+	"a280": 2579, "att48": 10628, "att532": 27686, "bayg29": 1610, "bays29": 2020, "berlin52": 7542, "burma14": 3323,
+	"ch130": 6110, "ch150": 6528, "dantzig42": 699, "eil51": 426, "eil76": 538, "eil101": 629, "fri26": 937,
+	"gr17": 2085, "gr21": 2707, "gr24": 1272, "gr48": 5046, "gr96": 55209, "gr120": 6942, "gr137": 69853,
+	"gr202": 40160, "gr229": 134602, "gr431": 171414, "gr666": 294358, "kroA100": 21282, "kroB100": 22141,
+	"kroC100": 20749, "kroD100": 21294, "kroE100": 22068, "lin105": 14379, "pcb442": 50778, "pr76": 108159,
+	"rd100": 7910, "st70": 675, "ulysses16": 6859, "ulysses22": 7013,
+}
+
+// KnownOptimum returns the optimal tour length of the TSPLIB instance named name with or without the suffix ".tsp", -1 means unknown
+func KnownOptimum(name string) int {
+	if optimum, ok := optima[strings.TrimSuffix(name, ".tsp")]; ok {
+		return optimum
+	}
+	return -1
+}
+
+// bundled are the TSPLIB files of the bundled instances
+var bundled = map[string]string{
+	"burma14": `NAME: burma14
+TYPE: TSP
+COMMENT: 14-Staedte in Burma (Zaw Win)
+DIMENSION: 14
+EDGE_WEIGHT_TYPE: GEO
+EDGE_WEIGHT_FORMAT: FUNCTION 
+DISPLAY_DATA_TYPE: COORD_DISPLAY
+NODE_COORD_SECTION
+   1  16.47       96.10
+   2  16.47       94.44
+   3  20.09       92.54
+   4  22.39       93.37
+   5  25.23       97.24
+   6  22.00       96.05
+   7  20.47       97.02
+   8  17.20       96.29
+   9  16.30       97.38
+  10  14.05       98.12
+  11  16.53       97.38
+  12  21.52       95.59
+  13  19.41       97.13
+  14  20.09       94.55
+EOF
+`,
+	"ulysses16": `NAME: ulysses16.tsp
+TYPE: TSP
+COMMENT: Odyssey of Ulysses (Groetschel/Padberg)
+DIMENSION: 16
+EDGE_WEIGHT_TYPE: GEO
+DISPLAY_DATA_TYPE: COORD_DISPLAY
+NODE_COORD_SECTION
+ 1 38.24 20.42
+ 2 39.57 26.15
+ 3 40.56 25.32
+ 4 36.26 23.12
+ 5 33.48 10.54
+ 6 37.56 12.19
+ 7 38.42 13.11
+ 8 37.52 20.44
+ 9 41.23 9.10
+ 10 41.17 13.05
+ 11 36.08 -5.21
+ 12 38.47 15.13
+ 13 38.15 15.35
+ 14 37.51 15.17
+ 15 35.49 14.32
+ 16 39.36 19.56
+EOF
+`,
+}
+
+// Bundled returns *Problem of the bundled TSPLIB instance named name, it panics if the instance isn't bundled
+func Bundled(name string) *Problem {
+	text, ok := bundled[name]
+	if !ok {
+		panic(fmt.Sprintf("The TSP instance isn't bundled: %s", name))
+	}
+	p, err := Parse(strings.NewReader(text))
+	if err != nil {
+		panic(fmt.Sprintf("The bundled TSP instance %s is invalid: %v", name, err))
+	}
+	p.name, p.optimum = name, optima[name]
+	return p
+}
+
+// BundledNames returns the sorted names of the bundled instances
+func BundledNames() []string {
+	names := make([]string, 0, len(bundled))
+	for name := range bundled {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// init registers the bundled instances as the benchmarks "tsp_" + name, such as "tsp_burma14", whose chromosomes are the tours of Len() cities
+func init() {
+	for _, name := range BundledNames() {
+		p := Bundled(name)
+		b := benchmarks.NewBenchmark("tsp_"+name, benchmarks.IntEvaluator(p.Evaluate), 1, false).SetDims(p.Len(), p.Len(), nil)
+		if optimum := p.GetOptimum(); optimum > 0 {
+			b.SetOptimum(func(dim int) []float64 { return []float64{float64(optimum)} }, nil)
+		}
+		benchmarks.RegisterBenchmark(b)
+	}
+}
@@ -0,0 +1,123 @@
+// Package tsp provides the symmetric traveling salesman problems over permutation-encoded base.IntIndividual.
+//
+// The cities are indexed from 0 and a tour is a permutation of the cities, so the individuals can be varied by crossover.CxPartialyMatched, crossover.CxOrdered and the other permutation operators.
+// The problems can be parsed from the files of TSPLIB: http://comopt.ifi.uni-heidelberg.de/software/TSPLIB95/
+package tsp
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+)
+
+// Problem is a traveling salesman problem with the precomputed distance matrix
+type Problem struct {
+	name       string
+	comment    string
+	weightType string
+	distances  [][]int
+	optimum    int
+}
+
+// NewProblem returns *Problem named name with the square distance matrix distances (not copied), optimum is the optimal tour length and -1 means unknown
+func NewProblem(name string, distances [][]int, optimum int) *Problem {
+	for i, row := range distances {
+		if len(row) != len(distances) {
+			panic(fmt.Sprintf("The row %d of the distance matrix should have %d elements: %v", i, len(distances), len(row)))
+		}
+	}
+	return &Problem{name: name, weightType: "EXPLICIT", distances: distances, optimum: optimum}
+}
+
+// GetName returns the name of the problem
+func (p *Problem) GetName() string {
+	return p.name
+}
+
+// GetComment returns the comment of the TSPLIB file
+func (p *Problem) GetComment() string {
+	return p.comment
+}
+
+// GetEdgeWeightType returns the edge weight type of the TSPLIB file, such as EUC_2D, ATT, GEO and EXPLICIT
+func (p *Problem) GetEdgeWeightType() string {
+	return p.weightType
+}
+
+// Len returns the number of cities
+func (p *Problem) Len() int {
+	return len(p.distances)
+}
+
+// Distance returns the distance from the city i to the city j
+func (p *Problem) Distance(i, j int) int {
+	return p.distances[i][j]
+}
+
+// GetOptimum returns the optimal tour length, -1 means unknown
+func (p *Problem) GetOptimum() int {
+	return p.optimum
+}
+
+// TourLength returns the length of the closed tour
+func (p *Problem) TourLength(tour []int) int {
+	length := 0
+	for i, city := range tour {
+		length += p.distances[city][tour[(i+1)%len(tour)]]
+	}
+	return length
+}
+
+// IsTour returns if tour is a permutation of the cities
+func (p *Problem) IsTour(tour []int) bool {
+	if len(tour) != p.Len() {
+		return false
+	}
+	visited := make([]bool, len(tour))
+	for _, city := range tour {
+		if city < 0 || city >= len(tour) || visited[city] {
+			return false
+		}
+		visited[city] = true
+	}
+	return true
+}
+
+// Evaluate is the benchmarks.IntEvaluator of the problem, it returns the tour length of individual which should be minimized
+func (p *Problem) Evaluate(individual *base.IntIndividual) []float64 {
+	return []float64{float64(p.TourLength(individual.GetChromosome().([]int)))}
+}
+
+// RandomTour returns a random permutation of the cities
+func (p *Problem) RandomTour() []int {
+	return rand.Perm(p.Len())
+}
+
+// NewIndividual returns *base.IntIndividual of a random tour with fitness
+func (p *Problem) NewIndividual(fitness *base.Fitness) *base.IntIndividual {
+	return base.NewIntIndividual(p.RandomTour(), fitness)
+}
+
+// NearestNeighbourTour returns the tour constructed by visiting the nearest unvisited city from start
+func (p *Problem) NearestNeighbourTour(start int) []int {
+	n := p.Len()
+	tour := make([]int, 0, n)
+	visited := make([]bool, n)
+	for city := start; len(tour) < n; {
+		tour = append(tour, city)
+		visited[city] = true
+		next := -1
+		for j := 0; j < n; j++ {
+			if !visited[j] && (next < 0 || p.distances[city][j] < p.distances[city][next]) {
+				next = j
+			}
+		}
+		city = next
+	}
+	return tour
+}
+
+func (p *Problem) String() string {
+	return fmt.Sprintf("%s (%d cities, %s)", p.name, p.Len(), p.weightType)
+}
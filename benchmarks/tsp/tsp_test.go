@@ -0,0 +1,173 @@
+package tsp
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+	"github.com/sineatos/deag/tools/crossover"
+)
+
+// heldKarp returns the optimal tour length of p by the dynamic programming over the subsets of the cities
+func heldKarp(p *Problem) int {
+	n := p.Len()
+	inf := int(^uint(0) >> 1)
+	// dp[s][j] is the shortest path from the city 0 visiting the cities of s and ending at j
+	dp := make([][]int, 1<<uint(n))
+	for s := range dp {
+		dp[s] = make([]int, n)
+		for j := range dp[s] {
+			dp[s][j] = inf
+		}
+	}
+	dp[1][0] = 0
+	for s := 1; s < len(dp); s += 2 {
+		for j := 0; j < n; j++ {
+			if dp[s][j] == inf {
+				continue
+			}
+			for k := 1; k < n; k++ {
+				if s&(1<<uint(k)) == 0 {
+					t := s | 1<<uint(k)
+					if d := dp[s][j] + p.Distance(j, k); d < dp[t][k] {
+						dp[t][k] = d
+					}
+				}
+			}
+		}
+	}
+	best := inf
+	for j := 1; j < n; j++ {
+		if d := dp[len(dp)-1][j] + p.Distance(j, 0); d < best {
+			best = d
+		}
+	}
+	return best
+}
+
+func TestBundled(t *testing.T) {
+	tours := map[string][]int{
+		"burma14":   {1, 2, 14, 3, 4, 5, 6, 12, 7, 13, 8, 11, 9, 10},
+		"ulysses16": {1, 14, 13, 12, 7, 6, 15, 5, 11, 9, 10, 16, 3, 2, 4, 8},
+	}
+	if names := BundledNames(); len(names) != 2 || names[0] != "burma14" || names[1] != "ulysses16" {
+		t.Errorf("wrong bundled instances: %v", names)
+	}
+	for _, name := range BundledNames() {
+		p := Bundled(name)
+		if p.GetName() != name || p.GetEdgeWeightType() != "GEO" || p.GetOptimum() != KnownOptimum(name) {
+			t.Errorf("wrong instance %v with the optimum %v", p, p.GetOptimum())
+		}
+		if optimum := heldKarp(p); optimum != p.GetOptimum() {
+			t.Errorf("%s: expected the optimum %v, got %v", name, p.GetOptimum(), optimum)
+		}
+		// the optimal tours of TSPLIB are 1-based
+		tour := make([]int, len(tours[name]))
+		for i, city := range tours[name] {
+			tour[i] = city - 1
+		}
+		if !p.IsTour(tour) || p.TourLength(tour) != p.GetOptimum() {
+			t.Errorf("%s: the optimal tour should have the length %v: %v", name, p.GetOptimum(), p.TourLength(tour))
+		}
+		if nn := p.NearestNeighbourTour(0); !p.IsTour(nn) || p.TourLength(nn) < p.GetOptimum() {
+			t.Errorf("%s: invalid nearest neighbour tour %v", name, nn)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("the instance which isn't bundled should panic")
+		}
+	}()
+	Bundled("att48")
+}
+
+func TestParse(t *testing.T) {
+	euc := "NAME : square\nTYPE : TSP\nDIMENSION : 4\nEDGE_WEIGHT_TYPE : EUC_2D\nNODE_COORD_SECTION\n1 0 0\n2 3 0\n3 3 4\n4 0 4\nEOF\n"
+	p, err := Parse(strings.NewReader(euc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Len() != 4 || p.Distance(0, 2) != 5 || p.TourLength([]int{0, 1, 2, 3}) != 14 || p.GetOptimum() != -1 {
+		t.Errorf("wrong EUC_2D instance: %v", p)
+	}
+	att := strings.Replace(euc, "EUC_2D", "ATT", 1)
+	if p, err = Parse(strings.NewReader(att)); err != nil || p.Distance(0, 1) != 1 || p.Distance(0, 2) != 2 {
+		t.Errorf("wrong ATT distances: %v, %v", p, err)
+	}
+	explicit := map[string]string{
+		"FULL_MATRIX":    "0 1 2\n1 0 3\n2 3 0",
+		"UPPER_ROW":      "1 2\n3",
+		"LOWER_DIAG_ROW": "0\n1 0\n2 3 0",
+		"UPPER_DIAG_COL": "0 1 0 2 3 0",
+	}
+	for format, weights := range explicit {
+		text := "NAME: gr3\nTYPE: TSP\nDIMENSION: 3\nEDGE_WEIGHT_TYPE: EXPLICIT\nEDGE_WEIGHT_FORMAT: " + format + "\nEDGE_WEIGHT_SECTION\n" + weights + "\nEOF"
+		p, err := Parse(strings.NewReader(text))
+		if err != nil {
+			t.Errorf("%s: %v", format, err)
+			continue
+		}
+		if p.Distance(0, 1) != 1 || p.Distance(2, 0) != 2 || p.Distance(1, 2) != 3 || p.Distance(2, 1) != 3 {
+			t.Errorf("%s: wrong distances %v", format, p.distances)
+		}
+	}
+	for _, text := range []string{
+		strings.Replace(euc, "TSP", "CVRP", 1),
+		strings.Replace(euc, "EUC_2D", "EUC_3D", 1),
+		strings.Replace(euc, "4 0 4\n", "", 1),
+		"NAME: gr3\nTYPE: TSP\nDIMENSION: 3\nEDGE_WEIGHT_TYPE: EXPLICIT\nEDGE_WEIGHT_FORMAT: UPPER_ROW\nEDGE_WEIGHT_SECTION\n1 2 3 4\nEOF",
+	} {
+		if _, err := Parse(strings.NewReader(text)); err == nil {
+			t.Errorf("the invalid instance should fail: %v", text)
+		}
+	}
+	path := filepath.Join(t.TempDir(), "burma14.tsp")
+	if err := ioutil.WriteFile(path, []byte(bundled["burma14"]), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if p, err := Load(path); err != nil || p.GetOptimum() != 3323 {
+		t.Errorf("the loaded instance should know its optimum: %v, %v", p, err)
+	}
+}
+
+func TestCrossover(t *testing.T) {
+	p := Bundled("ulysses16")
+	fitness := base.NewFitness([]float64{-1.0})
+	for k := 0; k < 100; k++ {
+		ind1, ind2 := p.NewIndividual(fitness.Clone()), p.NewIndividual(fitness.Clone())
+		for _, cx := range []func(*base.IntIndividual, *base.IntIndividual) (*base.IntIndividual, *base.IntIndividual){crossover.CxPartialyMatched, crossover.CxOrdered} {
+			ind1, ind2 = cx(ind1, ind2)
+			for _, ind := range []*base.IntIndividual{ind1, ind2} {
+				tour := ind.GetChromosome().([]int)
+				if !p.IsTour(tour) {
+					t.Fatalf("the offspring should be a tour: %v", tour)
+				}
+				if v := p.Evaluate(ind)[0]; v < float64(p.GetOptimum()) {
+					t.Errorf("the tour is shorter than the optimum: %v", v)
+				}
+			}
+		}
+	}
+}
+
+func TestRegisteredBenchmarks(t *testing.T) {
+	for _, name := range BundledNames() {
+		p, b := Bundled(name), benchmarks.GetBenchmark("tsp_"+name)
+		if !b.IsInt() || b.IsMaximization() || b.CheckDim(p.Len()) != nil || b.CheckDim(p.Len()+1) == nil {
+			t.Errorf("tsp_%s should be minimized for the tours of %d cities", name, p.Len())
+		}
+		tour := b.Sample(p.Len()).([]int)
+		if !p.IsTour(tour) {
+			t.Errorf("tsp_%s: the random chromosome should be a tour: %v", name, tour)
+		}
+		if v := b.Evaluate(tour); len(v) != 1 || v[0] != float64(p.TourLength(tour)) {
+			t.Errorf("tsp_%s: the value should be the tour length %d: %v", name, p.TourLength(tour), v)
+		}
+		if v := b.GetOptimum(p.Len()); v[0] != float64(KnownOptimum(name)) {
+			t.Errorf("tsp_%s: the optimum should be %d: %v", name, KnownOptimum(name), v)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package tsp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Load returns *Problem parsed from the TSPLIB file of path
+func Load(path string) (*Problem, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return Parse(file)
+}
+
+// Parse returns *Problem parsed from the TSPLIB text read from r.
+//
+// The supported edge weight types are EUC_2D, CEIL_2D, MAN_2D, MAX_2D, ATT, GEO and EXPLICIT, where the explicit matrix can be
+// FULL_MATRIX, UPPER_ROW, LOWER_ROW, UPPER_DIAG_ROW, LOWER_DIAG_ROW, UPPER_COL, LOWER_COL, UPPER_DIAG_COL or LOWER_DIAG_COL.
+// The optimum is KnownOptimum(NAME) of the file.
+func Parse(r io.Reader) (*Problem, error) {
+	header := make(map[string]string)
+	var coords [][2]float64
+	var weights []float64
+	section := ""
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		if c := text[0]; (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') {
+			if text == "EOF" {
+				break
+			}
+			if index := strings.Index(text, ":"); index >= 0 {
+				header[strings.TrimSpace(text[:index])] = strings.TrimSpace(text[index+1:])
+				section = ""
+			} else {
+				section = text
+			}
+			continue
+		}
+		fields := strings.Fields(text)
+		switch section {
+		case "NODE_COORD_SECTION":
+			if len(fields) < 3 {
+				return nil, fmt.Errorf("line %d: invalid node coordinates: %s", line, text)
+			}
+			x, err1 := strconv.ParseFloat(fields[1], 64)
+			y, err2 := strconv.ParseFloat(fields[2], 64)
+			if err1 != nil || err2 != nil {
+				return nil, fmt.Errorf("line %d: invalid node coordinates: %s", line, text)
+			}
+			coords = append(coords, [2]float64{x, y})
+		case "EDGE_WEIGHT_SECTION":
+			for _, field := range fields {
+				w, err := strconv.ParseFloat(field, 64)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid edge weight: %s", line, field)
+				}
+				weights = append(weights, w)
+			}
+		case "":
+			return nil, fmt.Errorf("line %d: data out of sections: %s", line, text)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if t := header["TYPE"]; t != "TSP" && t != "ATSP" {
+		return nil, fmt.Errorf("unsupported problem type: %q", t)
+	}
+	n, err := strconv.Atoi(header["DIMENSION"])
+	if err != nil || n < 1 {
+		return nil, fmt.Errorf("invalid dimension: %q", header["DIMENSION"])
+	}
+	p := &Problem{name: header["NAME"], comment: header["COMMENT"], weightType: header["EDGE_WEIGHT_TYPE"], optimum: KnownOptimum(header["NAME"])}
+	if p.weightType == "EXPLICIT" {
+		p.distances, err = explicitDistances(n, header["EDGE_WEIGHT_FORMAT"], weights)
+	} else {
+		p.distances, err = coordDistances(n, p.weightType, coords)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// nint rounds x to the nearest integer as TSPLIB
+func nint(x float64) int {
+	return int(x + 0.5)
+}
+
+// geoRadians converts the coordinate in the format DDD.MM (degrees and minutes) to radians
+func geoRadians(x float64) float64 {
+	deg := math.Trunc(x)
+	return 3.141592 * (deg + 5.0*(x-deg)/3.0) / 180.0
+}
+
+// coordDistances returns the distance matrix of the n cities of coords by the edge weight type
+func coordDistances(n int, weightType string, coords [][2]float64) ([][]int, error) {
+	if len(coords) != n {
+		return nil, fmt.Errorf("expected %d node coordinates, got %d", n, len(coords))
+	}
+	var distance func(a, b [2]float64) int
+	switch weightType {
+	case "EUC_2D":
+		distance = func(a, b [2]float64) int {
+			return nint(math.Hypot(a[0]-b[0], a[1]-b[1]))
+		}
+	case "CEIL_2D":
+		distance = func(a, b [2]float64) int {
+			return int(math.Ceil(math.Hypot(a[0]-b[0], a[1]-b[1])))
+		}
+	case "MAN_2D":
+		distance = func(a, b [2]float64) int {
+			return nint(math.Abs(a[0]-b[0]) + math.Abs(a[1]-b[1]))
+		}
+	case "MAX_2D":
+		distance = func(a, b [2]float64) int {
+			return nint(math.Max(math.Abs(a[0]-b[0]), math.Abs(a[1]-b[1])))
+		}
+	case "ATT":
+		// the pseudo-Euclidean distance
+		distance = func(a, b [2]float64) int {
+			dx, dy := a[0]-b[0], a[1]-b[1]
+			r := math.Sqrt((dx*dx + dy*dy) / 10.0)
+			t := nint(r)
+			if float64(t) < r {
+				t++
+			}
+			return t
+		}
+	case "GEO":
+		// the geographical distance on the idealized sphere with the radius 6378.388 km
+		distance = func(a, b [2]float64) int {
+			lat1, lon1, lat2, lon2 := geoRadians(a[0]), geoRadians(a[1]), geoRadians(b[0]), geoRadians(b[1])
+			q1, q2, q3 := math.Cos(lon1-lon2), math.Cos(lat1-lat2), math.Cos(lat1+lat2)
+			return int(6378.388*math.Acos(0.5*((1.0+q1)*q2-(1.0-q1)*q3)) + 1.0)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported edge weight type: %q", weightType)
+	}
+	distances := make([][]int, n)
+	for i := range distances {
+		distances[i] = make([]int, n)
+		for j := range distances[i] {
+			if i != j {
+				distances[i][j] = distance(coords[i], coords[j])
+			}
+		}
+	}
+	return distances, nil
+}
+
+// explicitDistances returns the distance matrix of n cities filled by weights in the edge weight format
+func explicitDistances(n int, format string, weights []float64) ([][]int, error) {
+	// the column-wise formats of a symmetric matrix are the same as the row-wise formats of the other triangle
+	switch format {
+	case "UPPER_COL":
+		format = "LOWER_ROW"
+	case "LOWER_COL":
+		format = "UPPER_ROW"
+	case "UPPER_DIAG_COL":
+		format = "LOWER_DIAG_ROW"
+	case "LOWER_DIAG_COL":
+		format = "UPPER_DIAG_ROW"
+	}
+	var inRow func(i, j int) bool
+	switch format {
+	case "FULL_MATRIX":
+		inRow = func(i, j int) bool { return true }
+	case "UPPER_ROW":
+		inRow = func(i, j int) bool { return j > i }
+	case "LOWER_ROW":
+		inRow = func(i, j int) bool { return j < i }
+	case "UPPER_DIAG_ROW":
+		inRow = func(i, j int) bool { return j >= i }
+	case "LOWER_DIAG_ROW":
+		inRow = func(i, j int) bool { return j <= i }
+	default:
+		return nil, fmt.Errorf("unsupported edge weight format: %q", format)
+	}
+	distances := make([][]int, n)
+	for i := range distances {
+		distances[i] = make([]int, n)
+	}
+	k := 0
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if !inRow(i, j) {
+				continue
+			}
+			if k >= len(weights) {
+				return nil, fmt.Errorf("too few edge weights for %d cities in %s: %d", n, format, len(weights))
+			}
+			w := int(math.Floor(weights[k] + 0.5))
+			distances[i][j] = w
+			if format != "FULL_MATRIX" {
+				distances[j][i] = w
+			}
+			k++
+		}
+	}
+	if k != len(weights) {
+		return nil, fmt.Errorf("too many edge weights for %d cities in %s: %d", n, format, len(weights))
+	}
+	return distances, nil
+}
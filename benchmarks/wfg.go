@@ -0,0 +1,373 @@
+package benchmarks
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sineatos/deag/base"
+)
+
+// The WFG toolkit.
+//
+// From: S. Huband, P. Hingston, L. Barone and L. While. A Review of Multiobjective Test Problems and a Scalable Test Problem Toolkit. IEEE Transactions on Evolutionary Computation, vol. 10, no. 5, p. 477 - 506, 2006.
+//
+// A WFG problem with m objectives has n = k + l variables, where the first k variables are the position parameters and the last l variables are the distance parameters.
+// The domain of the i-th variable (1-based) is [0, 2i]. k must be a multiple of m-1 and l must be positive (WFG2 and WFG3 require an even l).
+// The variables are normalized into z in [0, 1] and transformed into the vector t of m values by the transformation functions, then the objectives are
+//
+// `x_i = \\max(t_m, A_i)(t_i - 0.5) + 0.5, i = 1, \\ldots, m-1`
+//
+// `x_m = t_m`
+//
+// `f_i = x_m + 2i h_i(x_1, \\ldots, x_{m-1})`
+//
+// Where `h_i` is the shape function of the i-th objective and `A_i` is 1 unless the Pareto front is degenerate.
+// A solution is Pareto optimal if its distance parameters are optimal, which are 0.35 after the normalization for WFG1-7.
+
+// WFGNormalize returns the variables of individual normalized into [0, 1] by the domains [0, 2i]. It panics if the parameters k and l = n - k are invalid for obj objectives.
+func WFGNormalize(individual *base.Float64Individual, obj, k int) []float64 {
+	chrom := individual.GetChromosome().([]float64)
+	if obj < 2 || k < 1 || k%(obj-1) != 0 || len(chrom) <= k {
+		panic(fmt.Sprintf("Invalid WFG parameters: obj=%v, k=%v, n=%v", obj, k, len(chrom)))
+	}
+	z := make([]float64, len(chrom))
+	for i, ch := range chrom {
+		z[i] = ch / (2.0 * float64(i+1))
+	}
+	return z
+}
+
+// WFGObjectives returns the objectives of the transformed vector t with the degeneracy constants a of length len(t)-1 and the shape functions h(x, i), where i is the 1-based index of the objective
+func WFGObjectives(t, a []float64, h func(x []float64, i int) float64) []float64 {
+	m := len(t)
+	x := make([]float64, m-1)
+	for i := range x {
+		x[i] = math.Max(t[m-1], a[i])*(t[i]-0.5) + 0.5
+	}
+	f := make([]float64, m)
+	for i := range f {
+		f[i] = t[m-1] + 2.0*float64(i+1)*h(x, i+1)
+	}
+	return f
+}
+
+// WFGLinear is the linear shape function of the i-th objective
+//
+// `h_1 = \\prod_{j=1}^{m-1} x_j`, `h_i = (1 - x_{m-i+1}) \\prod_{j=1}^{m-i} x_j`, `h_m = 1 - x_1`
+func WFGLinear(x []float64, i int) float64 {
+	m := len(x) + 1
+	ans := 1.0
+	for _, v := range x[:m-i] {
+		ans *= v
+	}
+	if i > 1 {
+		ans *= 1.0 - x[m-i]
+	}
+	return ans
+}
+
+// WFGConvex is the convex shape function of the i-th objective
+//
+// `h_1 = \\prod_{j=1}^{m-1} (1 - \\cos(x_j\pi/2))`, `h_i = (1 - \\sin(x_{m-i+1}\pi/2)) \\prod_{j=1}^{m-i} (1 - \\cos(x_j\pi/2))`, `h_m = 1 - \\sin(x_1\pi/2)`
+func WFGConvex(x []float64, i int) float64 {
+	m := len(x) + 1
+	ans := 1.0
+	for _, v := range x[:m-i] {
+		ans *= 1.0 - math.Cos(0.5*math.Pi*v)
+	}
+	if i > 1 {
+		ans *= 1.0 - math.Sin(0.5*math.Pi*x[m-i])
+	}
+	return ans
+}
+
+// WFGConcave is the concave shape function of the i-th objective
+//
+// `h_1 = \\prod_{j=1}^{m-1} \\sin(x_j\pi/2)`, `h_i = \\cos(x_{m-i+1}\pi/2) \\prod_{j=1}^{m-i} \\sin(x_j\pi/2)`, `h_m = \\cos(x_1\pi/2)`
+func WFGConcave(x []float64, i int) float64 {
+	m := len(x) + 1
+	ans := 1.0
+	for _, v := range x[:m-i] {
+		ans *= math.Sin(0.5 * math.Pi * v)
+	}
+	if i > 1 {
+		ans *= math.Cos(0.5 * math.Pi * x[m-i])
+	}
+	return ans
+}
+
+// WFGMixed is the mixed convex/concave shape function of the last objective with A segments
+//
+// `h_m = \\left(1 - x_1 - \\frac{\\cos(2A\pi x_1 + \pi/2)}{2A\pi}\\right)^\\alpha`
+func WFGMixed(x []float64, A, alpha float64) float64 {
+	tmp := 2.0 * A * math.Pi
+	return math.Pow(1.0-x[0]-math.Cos(tmp*x[0]+0.5*math.Pi)/tmp, alpha)
+}
+
+// WFGDisc is the disconnected shape function of the last objective with A regions
+//
+// `h_m = 1 - x_1^\\alpha \\cos^2(A x_1^\\beta \pi)`
+func WFGDisc(x []float64, A, alpha, beta float64) float64 {
+	tmp := math.Cos(A * math.Pow(x[0], beta) * math.Pi)
+	return 1.0 - math.Pow(x[0], alpha)*tmp*tmp
+}
+
+// WFGBPoly is the polynomial bias transformation `y^\\alpha`
+func WFGBPoly(y, alpha float64) float64 {
+	return wfgCorrect(math.Pow(y, alpha))
+}
+
+// WFGBFlat is the flat region bias transformation, the values in [B, C] are mapped to A
+func WFGBFlat(y, A, B, C float64) float64 {
+	tmp1 := math.Min(0.0, math.Floor(y-B)) * A * (B - y) / B
+	tmp2 := math.Min(0.0, math.Floor(C-y)) * (1.0 - A) * (y - C) / (1.0 - C)
+	return wfgCorrect(A + tmp1 - tmp2)
+}
+
+// WFGBParam is the parameter dependent bias transformation, u is the reduced value of the other parameters
+//
+// `y^{B + (C - B)(A - (1 - 2u)|\\lfloor 0.5 - u \\rfloor + A|)}`
+func WFGBParam(y, u, A, B, C float64) float64 {
+	v := A - (1.0-2.0*u)*math.Abs(math.Floor(0.5-u)+A)
+	return wfgCorrect(math.Pow(y, B+(C-B)*v))
+}
+
+// WFGSLinear is the linear shift transformation, whose optimum is A
+func WFGSLinear(y, A float64) float64 {
+	return wfgCorrect(math.Abs(y-A) / math.Abs(math.Floor(A-y)+A))
+}
+
+// WFGSDecept is the deceptive shift transformation, whose global optimum is A with the aperture B and the deceptive optima of the value C are at 0 and 1
+func WFGSDecept(y, A, B, C float64) float64 {
+	tmp1 := math.Floor(y-A+B) * (1.0 - C + (A-B)/B) / (A - B)
+	tmp2 := math.Floor(A+B-y) * (1.0 - C + (1.0-A-B)/B) / (1.0 - A - B)
+	return wfgCorrect(1.0 + (math.Abs(y-A)-B)*(tmp1+tmp2+1.0/B))
+}
+
+// WFGSMulti is the multi-modal shift transformation, whose global optimum is C with A minima and the hill size B
+func WFGSMulti(y, A, B, C float64) float64 {
+	tmp1 := math.Abs(y-C) / (2.0 * (math.Floor(C-y) + C))
+	tmp2 := (4.0*A + 2.0) * math.Pi * (0.5 - tmp1)
+	return wfgCorrect((1.0 + math.Cos(tmp2) + 4.0*B*tmp1*tmp1) / (B + 2.0))
+}
+
+// WFGRSum is the weighted sum reduction transformation
+func WFGRSum(y, w []float64) float64 {
+	num, den := 0.0, 0.0
+	for i, v := range y {
+		num += w[i] * v
+		den += w[i]
+	}
+	return wfgCorrect(num / den)
+}
+
+// WFGRNonsep is the non-separable reduction transformation with the degree of dependency A, len(y) must be a multiple of A
+func WFGRNonsep(y []float64, A int) float64 {
+	n := len(y)
+	num := 0.0
+	for j, v := range y {
+		num += v
+		for k := 0; k <= A-2; k++ {
+			num += math.Abs(v - y[(j+k+1)%n])
+		}
+	}
+	half := math.Ceil(float64(A) / 2.0)
+	den := float64(n) / float64(A) * half * (1.0 + 2.0*float64(A) - 2.0*half)
+	return wfgCorrect(num / den)
+}
+
+// wfgCorrect corrects the rounding errors of the transformations into [0, 1]
+func wfgCorrect(v float64) float64 {
+	return math.Min(1.0, math.Max(0.0, v))
+}
+
+// wfgReduce reduces the position parameters y[:k] into m-1 groups and the distance parameters y[k:] into one value by reduce
+func wfgReduce(y []float64, k, m int, reduce func(y []float64, first int) float64) []float64 {
+	t := make([]float64, m)
+	size := k / (m - 1)
+	for i := 0; i < m-1; i++ {
+		t[i] = reduce(y[i*size:(i+1)*size], i*size)
+	}
+	t[m-1] = reduce(y[k:], k)
+	return t
+}
+
+// wfgSumReduce reduces y by WFGRSum with the weights 1
+func wfgSumReduce(y []float64, k, m int) []float64 {
+	return wfgReduce(y, k, m, func(y []float64, first int) float64 {
+		return WFGRSum(y, repeat(1.0, len(y)))
+	})
+}
+
+// wfgNonsepReduce reduces each group of y by WFGRNonsep with the degree of dependency of the group size
+func wfgNonsepReduce(y []float64, k, m int) []float64 {
+	return wfgReduce(y, k, m, func(y []float64, first int) float64 {
+		return WFGRNonsep(y, len(y))
+	})
+}
+
+// wfgShape returns the shape function using h for the first m-1 objectives and last for the m-th objective
+func wfgShape(h func(x []float64, i int) float64, last func(x []float64) float64) func(x []float64, i int) float64 {
+	return func(x []float64, i int) float64 {
+		if i == len(x)+1 {
+			return last(x)
+		}
+		return h(x, i)
+	}
+}
+
+// wfgLinearShift applies WFGSLinear(y, 0.35) to the distance parameters y[k:]
+func wfgLinearShift(y []float64, k int) []float64 {
+	ans := make([]float64, len(y))
+	copy(ans, y[:k])
+	for i := k; i < len(y); i++ {
+		ans[i] = WFGSLinear(y[i], 0.35)
+	}
+	return ans
+}
+
+// wfg2Reduce is the non-separable transformation and the reduction of WFG2 and WFG3
+func wfg2Reduce(z []float64, k, m int) []float64 {
+	l := len(z) - k
+	if l%2 != 0 {
+		panic(fmt.Sprintf("The number of distance parameters should be even: %v", l))
+	}
+	y := wfgLinearShift(z, k)
+	y2 := make([]float64, k+l/2)
+	copy(y2, y[:k])
+	for i := k; i < k+l/2; i++ {
+		j := k + 2*(i-k)
+		y2[i] = WFGRNonsep(y[j:j+2], 2)
+	}
+	return wfgSumReduce(y2, k, m)
+}
+
+// wfgParamBias applies WFGBParam to y with the reduced value u of the other parameters
+func wfgParamBias(y, u float64) float64 {
+	return WFGBParam(y, u, 0.98/49.98, 0.02, 50.0)
+}
+
+// WFG1 multiobjective function. It returns a slice of obj values.
+//
+// The first k variables are the position parameters. The Pareto front is convex and mixed with a flat bias and a polynomial bias.
+func WFG1(individual *base.Float64Individual, obj, k int) []float64 {
+	z := WFGNormalize(individual, obj, k)
+	y := wfgLinearShift(z, k)
+	for i := k; i < len(y); i++ {
+		y[i] = WFGBFlat(y[i], 0.8, 0.75, 0.85)
+	}
+	for i := range y {
+		y[i] = WFGBPoly(y[i], 0.02)
+	}
+	t := wfgReduce(y, k, obj, func(y []float64, first int) float64 {
+		w := make([]float64, len(y))
+		for i := range w {
+			w[i] = 2.0 * float64(first+i+1)
+		}
+		return WFGRSum(y, w)
+	})
+	return WFGObjectives(t, repeat(1.0, obj-1), wfgShape(WFGConvex, func(x []float64) float64 {
+		return WFGMixed(x, 5.0, 1.0)
+	}))
+}
+
+// WFG2 multiobjective function. It returns a slice of obj values.
+//
+// The first k variables are the position parameters and the number of distance parameters must be even. The Pareto front is convex and disconnected and the problem is non-separable.
+func WFG2(individual *base.Float64Individual, obj, k int) []float64 {
+	t := wfg2Reduce(WFGNormalize(individual, obj, k), k, obj)
+	return WFGObjectives(t, repeat(1.0, obj-1), wfgShape(WFGConvex, func(x []float64) float64 {
+		return WFGDisc(x, 5.0, 1.0, 1.0)
+	}))
+}
+
+// WFG3 multiobjective function. It returns a slice of obj values.
+//
+// The first k variables are the position parameters and the number of distance parameters must be even. The Pareto front is linear and degenerate, it is a line for any number of objectives.
+func WFG3(individual *base.Float64Individual, obj, k int) []float64 {
+	t := wfg2Reduce(WFGNormalize(individual, obj, k), k, obj)
+	a := make([]float64, obj-1)
+	a[0] = 1.0
+	return WFGObjectives(t, a, WFGLinear)
+}
+
+// WFG4 multiobjective function. It returns a slice of obj values.
+//
+// The first k variables are the position parameters. The Pareto front is concave and the problem is multi-modal.
+func WFG4(individual *base.Float64Individual, obj, k int) []float64 {
+	z := WFGNormalize(individual, obj, k)
+	for i := range z {
+		z[i] = WFGSMulti(z[i], 30.0, 10.0, 0.35)
+	}
+	return WFGObjectives(wfgSumReduce(z, k, obj), repeat(1.0, obj-1), WFGConcave)
+}
+
+// WFG5 multiobjective function. It returns a slice of obj values.
+//
+// The first k variables are the position parameters. The Pareto front is concave and the problem is deceptive.
+func WFG5(individual *base.Float64Individual, obj, k int) []float64 {
+	z := WFGNormalize(individual, obj, k)
+	for i := range z {
+		z[i] = WFGSDecept(z[i], 0.35, 0.001, 0.05)
+	}
+	return WFGObjectives(wfgSumReduce(z, k, obj), repeat(1.0, obj-1), WFGConcave)
+}
+
+// WFG6 multiobjective function. It returns a slice of obj values.
+//
+// The first k variables are the position parameters. The Pareto front is concave and the problem is non-separable.
+func WFG6(individual *base.Float64Individual, obj, k int) []float64 {
+	y := wfgLinearShift(WFGNormalize(individual, obj, k), k)
+	return WFGObjectives(wfgNonsepReduce(y, k, obj), repeat(1.0, obj-1), WFGConcave)
+}
+
+// WFG7 multiobjective function. It returns a slice of obj values.
+//
+// The first k variables are the position parameters. The Pareto front is concave and the position parameters are biased by the distance parameters.
+func WFG7(individual *base.Float64Individual, obj, k int) []float64 {
+	z := WFGNormalize(individual, obj, k)
+	y := make([]float64, len(z))
+	copy(y, z)
+	for i := 0; i < k; i++ {
+		y[i] = wfgParamBias(z[i], WFGRSum(z[i+1:], repeat(1.0, len(z)-i-1)))
+	}
+	y = wfgLinearShift(y, k)
+	return WFGObjectives(wfgSumReduce(y, k, obj), repeat(1.0, obj-1), WFGConcave)
+}
+
+// WFG8 multiobjective function. It returns a slice of obj values.
+//
+// The first k variables are the position parameters. The Pareto front is concave and the distance parameters are biased by the preceding parameters,
+// so the optimal distance parameters depend on the position parameters.
+func WFG8(individual *base.Float64Individual, obj, k int) []float64 {
+	z := WFGNormalize(individual, obj, k)
+	y := make([]float64, len(z))
+	copy(y, z)
+	for i := k; i < len(z); i++ {
+		y[i] = wfgParamBias(z[i], WFGRSum(z[:i], repeat(1.0, i)))
+	}
+	y = wfgLinearShift(y, k)
+	return WFGObjectives(wfgSumReduce(y, k, obj), repeat(1.0, obj-1), WFGConcave)
+}
+
+// WFG9 multiobjective function. It returns a slice of obj values.
+//
+// The first k variables are the position parameters. The Pareto front is concave and the problem is non-separable, deceptive and multi-modal.
+// The optimal distance parameters depend on the following parameters.
+func WFG9(individual *base.Float64Individual, obj, k int) []float64 {
+	z := WFGNormalize(individual, obj, k)
+	n := len(z)
+	y := make([]float64, n)
+	for i := 0; i < n-1; i++ {
+		y[i] = wfgParamBias(z[i], WFGRSum(z[i+1:], repeat(1.0, n-i-1)))
+	}
+	y[n-1] = z[n-1]
+	for i := range y {
+		if i < k {
+			y[i] = WFGSDecept(y[i], 0.35, 0.001, 0.05)
+		} else {
+			y[i] = WFGSMulti(y[i], 30.0, 95.0, 0.35)
+		}
+	}
+	return WFGObjectives(wfgNonsepReduce(y, k, obj), repeat(1.0, obj-1), WFGConcave)
+}
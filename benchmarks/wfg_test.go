@@ -0,0 +1,128 @@
+package benchmarks
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+)
+
+// wfgOptimalIndividual returns a random Pareto optimal individual of WFG1-7 with k position parameters and l distance parameters
+func wfgOptimalIndividual(k, l int) *base.Float64Individual {
+	values := make([]float64, k+l)
+	for i := range values {
+		if i < k {
+			values[i] = 2.0 * float64(i+1) * rand.Float64()
+		} else {
+			values[i] = 2.0 * float64(i+1) * 0.35
+		}
+	}
+	return base.NewFloat64Individual(values, base.NewFitness([]float64{-1.0, -1.0, -1.0}))
+}
+
+// wfgBiasedOptimum returns the normalized value whose parameter dependent bias with the reduced value u is 0.35
+func wfgBiasedOptimum(u float64) float64 {
+	A, B, C := 0.98/49.98, 0.02, 50.0
+	return math.Pow(0.35, 1.0/(B+(C-B)*(A-(1.0-2.0*u)*math.Abs(math.Floor(0.5-u)+A))))
+}
+
+// checkSphere checks if sum((f_i / 2i)^2) = 1, which is the concave Pareto front of WFG4-9
+func checkSphere(t *testing.T, name string, f []float64) {
+	total := 0.0
+	for i, v := range f {
+		total += math.Pow(v/(2.0*float64(i+1)), 2.0)
+	}
+	if math.Abs(total-1.0) > 1E-9 {
+		t.Errorf("%s: %v isn't on the Pareto front", name, f)
+	}
+}
+
+func TestWFGFront(t *testing.T) {
+	for obj := 2; obj <= 4; obj++ {
+		k, l := 2*(obj-1), 10
+		for n := 0; n < 10; n++ {
+			individual := wfgOptimalIndividual(k, l)
+			checkSphere(t, "WFG4", WFG4(individual, obj, k))
+			checkSphere(t, "WFG5", WFG5(individual, obj, k))
+			checkSphere(t, "WFG6", WFG6(individual, obj, k))
+			checkSphere(t, "WFG7", WFG7(individual, obj, k))
+			// the Pareto front of WFG3 is the degenerate hyperplane sum(f_i / 2i) = 1
+			total := 0.0
+			for i, v := range WFG3(individual, obj, k) {
+				total += v / (2.0 * float64(i+1))
+			}
+			checkValue(t, 1.0, total, obj)
+
+			x := individual.GetChromosome().([]float64)
+			z := make([]float64, len(x))
+			for i := range z {
+				z[i] = x[i] / (2.0 * float64(i+1))
+			}
+			// the optimal distance parameters of WFG8 depend on the preceding parameters
+			for i := k; i < len(z); i++ {
+				z[i] = wfgBiasedOptimum(WFGRSum(z[:i], repeat(1.0, i)))
+				x[i] = 2.0 * float64(i+1) * z[i]
+			}
+			checkSphere(t, "WFG8", WFG8(individual, obj, k))
+			// the optimal distance parameters of WFG9 depend on the following parameters
+			z[len(z)-1] = 0.35
+			for i := len(z) - 2; i >= k; i-- {
+				z[i] = wfgBiasedOptimum(WFGRSum(z[i+1:], repeat(1.0, len(z)-i-1)))
+			}
+			for i := k; i < len(z); i++ {
+				x[i] = 2.0 * float64(i+1) * z[i]
+			}
+			checkSphere(t, "WFG9", WFG9(individual, obj, k))
+		}
+	}
+}
+
+func TestWFGDistance(t *testing.T) {
+	obj, k, l := 3, 4, 6
+	for _, wfg := range []func(*base.Float64Individual, int, int) []float64{WFG1, WFG2, WFG4, WFG5, WFG6} {
+		individual := wfgOptimalIndividual(k, l)
+		optimal := wfg(individual, obj, k)
+		x := individual.GetChromosome().([]float64)
+		x[k+1] += 0.5
+		moved := wfg(individual, obj, k)
+		// the distance parameters only move the point away from the Pareto front along (1, ..., 1)
+		delta := moved[0] - optimal[0]
+		if delta <= 0.0 {
+			t.Errorf("%v should dominate %v", optimal, moved)
+		}
+		for i := range moved {
+			if math.Abs(moved[i]-optimal[i]-delta) > 1E-9 {
+				t.Errorf("%v isn't %v moved by %v", moved, optimal, delta)
+			}
+		}
+	}
+}
+
+func TestWFGTransformations(t *testing.T) {
+	for _, v := range []float64{WFGSLinear(0.35, 0.35), WFGSMulti(0.35, 30.0, 10.0, 0.35), WFGSDecept(0.35, 0.35, 0.001, 0.05), WFGBFlat(0.8, 0.8, 0.75, 0.85) - 0.8} {
+		checkValue(t, 0.0, v, 0)
+	}
+	checkValue(t, 0.05, WFGSDecept(0.0, 0.35, 0.001, 0.05), 0)
+	checkValue(t, 0.5, WFGRSum([]float64{0.0, 1.0}, []float64{1.0, 1.0}), 0)
+	checkValue(t, 0.0, WFGRNonsep([]float64{0.0, 0.0, 0.0}, 3), 0)
+	checkValue(t, 0.3, WFGRNonsep([]float64{0.2, 0.4}, 1), 0)
+	x := []float64{0.3, 0.6}
+	for name, h := range map[string]func([]float64, int) float64{"linear": WFGLinear, "concave": WFGConcave} {
+		total := 0.0
+		for i := 1; i <= 3; i++ {
+			v := h(x, i)
+			if name == "concave" {
+				v *= v
+			}
+			total += v
+		}
+		checkValue(t, 1.0, total, 0)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("the invalid parameters should panic")
+		}
+	}()
+	WFG4(wfgOptimalIndividual(3, 4), 3, 3)
+}
@@ -0,0 +1,118 @@
+package gp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sineatos/deag/base"
+)
+
+// MultiTree is the individual of GP with automatically defined functions (ADFs) [Koza1994].
+// The first tree is the main program and the other trees are the ADFs, each tree is built with its own PrimitiveSet,
+// and a tree can call the ADFs after it by the primitives added by PrimitiveSet.AddADF.
+// Each tree has a placeholder fitness with the weights of the MultiTree, so that a tree can be cloned and varied alone,
+// but only the fitness of the MultiTree is evaluated.
+// The chromosome of MultiTree is []*Tree.
+//
+// [Koza1994] Koza, "Genetic Programming II: Automatic Discovery of Reusable Programs", 1994.
+type MultiTree struct {
+	// Chromosome
+	trees []*Tree
+
+	// Fitness
+	fitness *base.Fitness
+}
+
+// NewMultiTree returns *MultiTree whose trees are built from exprs, exprs[0] is the main program
+func NewMultiTree(exprs [][]Node, fitness *base.Fitness) *MultiTree {
+	trees := make([]*Tree, len(exprs))
+	for i, expr := range exprs {
+		trees[i] = NewTree(expr, base.NewFitness(fitness.GetWeights()))
+	}
+	return &MultiTree{trees: trees, fitness: fitness}
+}
+
+// GenMultiTree returns the expressions of a MultiTree, the i-th expression is generated by gen with psets[i], such as GenMultiTree(psets, GenHalfAndHalf, 1, 2)
+func GenMultiTree(psets []*PrimitiveSet, gen func(pset *PrimitiveSet, min, max int) []Node, min, max int) [][]Node {
+	exprs := make([][]Node, len(psets))
+	for i, pset := range psets {
+		exprs[i] = gen(pset, min, max)
+	}
+	return exprs
+}
+
+// Len returns the number of trees
+func (ind *MultiTree) Len() int {
+	return len(ind.trees)
+}
+
+// Clone returns an copy of MultiTree
+func (ind *MultiTree) Clone() interface{} {
+	trees := make([]*Tree, len(ind.trees))
+	for i, tree := range ind.trees {
+		trees[i] = NewTree(copyNodes(tree.nodes), base.NewFitness(ind.fitness.GetWeights()))
+	}
+	return &MultiTree{trees: trees, fitness: ind.fitness.Clone()}
+}
+
+// GetChromosome gets the tree slice (not a copy)
+func (ind *MultiTree) GetChromosome() interface{} {
+	return ind.trees
+}
+
+// SetChromosome sets the tree slice, the nodes of the trees are copied
+func (ind *MultiTree) SetChromosome(chromosome interface{}) {
+	trees, ok := chromosome.([]*Tree)
+	if !ok {
+		panic(fmt.Sprintf("Chromosome should be a []*gp.Tree: %v", chromosome))
+	}
+	ind.trees = make([]*Tree, len(trees))
+	for i, tree := range trees {
+		ind.trees[i] = NewTree(copyNodes(tree.nodes), base.NewFitness(ind.fitness.GetWeights()))
+	}
+}
+
+// GetFitness returns the individual's fitness (not a copy)
+func (ind *MultiTree) GetFitness() *base.Fitness {
+	return ind.fitness
+}
+
+// IsEqual returns if the other individual is a MultiTree with the same trees
+func (ind *MultiTree) IsEqual(other base.Individual) bool {
+	if otherInd, ok := other.(*MultiTree); ok && len(otherInd.trees) == len(ind.trees) {
+		for i, tree := range ind.trees {
+			if !tree.IsEqual(otherInd.trees[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// GetTree returns the i-th tree (not a copy), the variation operators of Tree can be applied to it
+func (ind *MultiTree) GetTree(i int) *Tree {
+	return ind.trees[i]
+}
+
+// IsValid returns if the i-th tree is a valid expression of psets[i] for all the trees
+func (ind *MultiTree) IsValid(psets []*PrimitiveSet) bool {
+	if len(psets) != len(ind.trees) {
+		return false
+	}
+	for i, tree := range ind.trees {
+		if !tree.IsValid(psets[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the prefix representations of the trees, such as [add(ADF0(x, x), 1), mul(ARG0, ARG1)]
+func (ind *MultiTree) String() string {
+	strs := make([]string, len(ind.trees))
+	for i, tree := range ind.trees {
+		strs[i] = tree.String()
+	}
+	return fmt.Sprintf("[%s]", strings.Join(strs, ", "))
+}
@@ -0,0 +1,89 @@
+package gp
+
+import (
+	"testing"
+
+	"github.com/sineatos/deag/base"
+)
+
+// newADFPrimitiveSets returns the PrimitiveSets of the main program and two ADFs, MAIN can call ADF0 and ADF1, ADF0 can call ADF1
+func newADFPrimitiveSets() []*PrimitiveSet {
+	add := func(args ...interface{}) interface{} { return args[0].(float64) + args[1].(float64) }
+	mul := func(args ...interface{}) interface{} { return args[0].(float64) * args[1].(float64) }
+	adf1 := NewPrimitiveSet("ADF1", 2)
+	adf1.AddUntypedPrimitive("add", add, 2)
+	adf1.AddUntypedPrimitive("mul", mul, 2)
+	adf0 := NewPrimitiveSet("ADF0", 1)
+	adf0.AddUntypedPrimitive("add", add, 2)
+	adf0.AddUntypedTerminal("one", 1.0)
+	adf0.AddADF(adf1)
+	main := NewPrimitiveSet("MAIN", 1)
+	main.AddUntypedPrimitive("add", add, 2)
+	main.AddUntypedPrimitive("mul", mul, 2)
+	main.AddUntypedTerminal("two", 2.0)
+	main.AddADF(adf0)
+	main.AddADF(adf1)
+	main.RenameArguments("x")
+	return []*PrimitiveSet{main, adf0, adf1}
+}
+
+func TestADF(t *testing.T) {
+	psets := newADFPrimitiveSets()
+	if prim, ok := psets[0].Lookup("ADF0").(*Primitive); !ok || !prim.IsADF() || prim.GetArity() != 1 {
+		t.Errorf("ADF0 should be an ADF primitive with 1 argument: %v", psets[0].Lookup("ADF0"))
+	}
+	fit := base.NewFitness([]float64{-1.0})
+	exprs := make([][]Node, len(psets))
+	// ADF1(a, b) = a * b, ADF0(a) = ADF1(a, a) + 1, MAIN(x) = ADF0(x) + ADF1(x, 2)
+	for i, expr := range []string{"add(ADF0(x), ADF1(x, two))", "add(ADF1(ARG0, ARG0), one)", "mul(ARG0, ARG1)"} {
+		exprs[i] = NewTreeFromString(expr, psets[i], nil).nodes
+	}
+	ind := NewMultiTree(exprs, fit)
+	if !ind.IsValid(psets) {
+		t.Errorf("the individual should be valid: %v", ind)
+	}
+	fn := CompileADF(ind, psets)
+	for _, x := range []float64{-1.0, 0.0, 3.0} {
+		if y := fn(x).(float64); y != x*x+1.0+2.0*x {
+			t.Errorf("f(%v) should be %v: %v", x, x*x+1.0+2.0*x, y)
+		}
+	}
+	clone := ind.Clone().(*MultiTree)
+	if !clone.IsEqual(ind) {
+		t.Errorf("the clone should be equal to the individual: %v, %v", clone, ind)
+	}
+	clone.GetTree(2).SetChromosome(NewTreeFromString("add(ARG0, ARG1)", psets[2], nil).nodes)
+	if clone.IsEqual(ind) || ind.GetTree(2).String() != "mul(ARG0, ARG1)" {
+		t.Errorf("the clone shouldn't share the trees with the individual: %v, %v", clone, ind)
+	}
+	// a tree can be cloned alone, as the variation algorithms clone the offsprings before varying them
+	for i := range psets {
+		tree := ind.GetTree(i).Clone().(*Tree)
+		if !tree.IsEqual(ind.GetTree(i)) || tree.GetFitness() == nil || tree.GetFitness().Valid() {
+			t.Errorf("the clone of the tree %v should be equal to it with an invalid fitness: %v", ind.GetTree(i), tree)
+		}
+	}
+	// the trees are varied one by one
+	for n := 0; n < 100; n++ {
+		ind1 := NewMultiTree(GenMultiTree(psets, GenHalfAndHalf, 1, 3), fit.Clone())
+		ind2 := NewMultiTree(GenMultiTree(psets, GenFull, 1, 3), fit.Clone())
+		for i, pset := range psets {
+			CxOnePoint(ind1.GetTree(i), ind2.GetTree(i))
+			MutNodeReplacement(ind1.GetTree(i), pset)
+		}
+		if !ind1.IsValid(psets) || !ind2.IsValid(psets) {
+			t.Errorf("the individuals should be valid: %v, %v", ind1, ind2)
+		}
+		if _, ok := CompileADF(ind1, psets)(1.0).(float64); !ok {
+			t.Error("the compiled function should return float64")
+		}
+	}
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Compile doesn't panic with an ADF primitive")
+			}
+		}()
+		Compile(ind.GetTree(0).nodes, psets[0])
+	}()
+}
@@ -0,0 +1,38 @@
+package gp
+
+// StaticLimitCrossover returns the Crossover which applies mate and restores an offspring to its parent if key(offspring) > max.
+// The static limits of [Koza1989] are StaticLimitCrossover((*Tree).Height, 17, mate) and StaticLimitCrossover((*Tree).Len, size, mate).
+//
+// [Koza1989] Koza, "Genetic Programming", 1989.
+func StaticLimitCrossover(key func(*Tree) int, max int, mate Crossover) Crossover {
+	return func(ind1, ind2 *Tree) (*Tree, *Tree) {
+		keep1, keep2 := copyNodes(ind1.nodes), copyNodes(ind2.nodes)
+		ind1, ind2 = mate(ind1, ind2)
+		if key(ind1) > max {
+			ind1.SetChromosome(keep1)
+		}
+		if key(ind2) > max {
+			ind2.SetChromosome(keep2)
+		}
+		return ind1, ind2
+	}
+}
+
+// StaticLimitMutation returns the Mutation which applies mutate and restores the individual if key(mutant) > max, see StaticLimitCrossover
+func StaticLimitMutation(key func(*Tree) int, max int, mutate Mutation) Mutation {
+	return func(ind *Tree) *Tree {
+		keep := copyNodes(ind.nodes)
+		ind = mutate(ind)
+		if key(ind) > max {
+			ind.SetChromosome(keep)
+		}
+		return ind
+	}
+}
+
+// copyNodes returns a copy of nodes
+func copyNodes(nodes []Node) []Node {
+	ans := make([]Node, len(nodes))
+	copy(ans, nodes)
+	return ans
+}
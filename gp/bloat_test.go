@@ -0,0 +1,93 @@
+package gp
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/selection"
+)
+
+func TestStaticLimit(t *testing.T) {
+	pset := newArithmeticPrimitiveSet()
+	maxHeight := 5
+	mate := StaticLimitCrossover((*Tree).Height, maxHeight, CxOnePoint)
+	mutate := StaticLimitMutation((*Tree).Height, maxHeight, func(ind *Tree) *Tree {
+		return MutUniform(ind, func(ret Type) []Node { return GenFull(pset, 3, 3) })
+	})
+	for n := 0; n < 100; n++ {
+		ind1, ind2 := NewTree(GenFull(pset, 1, 5), nil), NewTree(GenFull(pset, 1, 5), nil)
+		ind1, ind2 = mate(ind1, ind2)
+		ind1, ind2 = mutate(ind1), mutate(ind2)
+		if ind1.Height() > maxHeight || ind2.Height() > maxHeight {
+			t.Errorf("the height of tree exceeds the limit: %v, %v", ind1, ind2)
+		}
+	}
+}
+
+// TestSymbolicRegression evolves x^4 + x^3 + x^2 + x with double tournament and static limits
+func TestSymbolicRegression(t *testing.T) {
+	pset := newArithmeticPrimitiveSet()
+	points := make([]float64, 0, 20)
+	for x := -1.0; x < 1.0; x += 0.1 {
+		points = append(points, x)
+	}
+	evaluate := func(ind *Tree) {
+		fn := CompileTree(ind, pset)
+		total := 0.0
+		for _, x := range points {
+			diff := fn(x).(float64) - (x*x*x*x + x*x*x + x*x + x)
+			total += diff * diff
+		}
+		ind.GetFitness().SetValues([]float64{total / float64(len(points))})
+	}
+	mate := StaticLimitCrossover((*Tree).Height, 17, CxOnePoint)
+	mutate := StaticLimitMutation((*Tree).Height, 17, func(ind *Tree) *Tree {
+		return MutUniform(ind, func(ret Type) []Node { return GenFull(pset, 0, 2) })
+	})
+	size, ngen, cxpb, mutpb := 100, 30, 0.5, 0.1
+	pop := make(base.Individuals, size)
+	for i := range pop {
+		pop[i] = NewTree(GenHalfAndHalf(pset, 1, 2), base.NewFitness([]float64{-1.0}))
+		evaluate(pop[i].(*Tree))
+	}
+	best := func() (base.Individual, float64) {
+		var elite base.Individual
+		ans := math.Inf(1)
+		for _, ind := range pop {
+			if v := ind.GetFitness().GetValues()[0]; v < ans {
+				elite, ans = ind, v
+			}
+		}
+		return elite, ans
+	}
+	_, first := best()
+	for gen := 0; gen < ngen; gen++ {
+		chosen := selection.SelDoubleTournament(pop, size, 3, 1.4, true)
+		offspring := make(base.Individuals, size)
+		for i, ind := range chosen {
+			offspring[i] = ind.Clone().(*Tree)
+		}
+		for i := 1; i < size; i += 2 {
+			if rand.Float64() < cxpb {
+				mate(offspring[i-1].(*Tree), offspring[i].(*Tree))
+			}
+		}
+		for _, ind := range offspring {
+			if rand.Float64() < mutpb {
+				mutate(ind.(*Tree))
+			}
+			evaluate(ind.(*Tree))
+		}
+		// elitism
+		elite, _ := best()
+		offspring[0] = elite.Clone().(*Tree)
+		pop = offspring
+	}
+	elite, last := best()
+	if last > first {
+		t.Errorf("the best fitness gets worse: %v -> %v", first, last)
+	}
+	t.Logf("best fitness: %v -> %v, %v", first, last, elite.(*Tree).Infix())
+}
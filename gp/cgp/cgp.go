@@ -0,0 +1,174 @@
+package cgp
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/sineatos/deag/gp"
+)
+
+// FunctionSet contains the functions which can be used by the nodes, the function gene of a node is the index of its function
+type FunctionSet struct {
+	names    []string
+	fns      []gp.Function
+	arities  []int
+	maxArity int
+}
+
+// NewFunctionSet returns an empty *FunctionSet
+func NewFunctionSet() *FunctionSet {
+	return &FunctionSet{}
+}
+
+// Add adds the function fn named name which takes arity arguments
+func (fs *FunctionSet) Add(name string, fn gp.Function, arity int) {
+	for _, n := range fs.names {
+		if n == name {
+			panic(fmt.Sprintf("Function names must be unique in a FunctionSet: %s", name))
+		}
+	}
+	if arity < 1 {
+		panic(fmt.Sprintf("The arity of %s should be positive: %d", name, arity))
+	}
+	fs.names = append(fs.names, name)
+	fs.fns = append(fs.fns, fn)
+	fs.arities = append(fs.arities, arity)
+	if arity > fs.maxArity {
+		fs.maxArity = arity
+	}
+}
+
+// Len returns the number of functions
+func (fs *FunctionSet) Len() int {
+	return len(fs.names)
+}
+
+// GetName returns the name of the i-th function
+func (fs *FunctionSet) GetName(i int) string {
+	return fs.names[i]
+}
+
+// GetArity returns the arity of the i-th function
+func (fs *FunctionSet) GetArity(i int) int {
+	return fs.arities[i]
+}
+
+// GetMaxArity returns the maximum arity of the functions, which is the number of connection genes of each node
+func (fs *FunctionSet) GetMaxArity() int {
+	return fs.maxArity
+}
+
+// Config is the shape of the graph of CGP [Miller2000].
+// The nodes are arranged in a grid of rows x columns, node i is in column i / rows. The addresses 0 ... inputs-1 are the inputs,
+// and the address of node i is inputs + i. A node in column c can connect to the inputs and the nodes in the columns [c-levelsBack, c-1],
+// the outputs can connect to any input or node.
+//
+// The genome is an int slice, each node has a function gene followed by GetMaxArity() connection genes, and the output genes are at the end.
+// The connection genes after the arity of the function are kept but inactive.
+//
+// [Miller2000] Miller and Thomson, "Cartesian genetic programming", 2000.
+type Config struct {
+	inputs     int
+	outputs    int
+	rows       int
+	columns    int
+	levelsBack int
+	functions  *FunctionSet
+}
+
+// NewConfig returns *Config, levelsBack is usually columns (no limit) when rows is 1
+func NewConfig(inputs, outputs, rows, columns, levelsBack int, functions *FunctionSet) *Config {
+	if inputs < 1 || outputs < 1 || rows < 1 || columns < 1 || levelsBack < 1 {
+		panic(fmt.Sprintf("inputs, outputs, rows, columns and levelsBack should be positive: %d, %d, %d, %d, %d", inputs, outputs, rows, columns, levelsBack))
+	}
+	if functions.Len() == 0 {
+		panic("The FunctionSet is empty")
+	}
+	return &Config{inputs: inputs, outputs: outputs, rows: rows, columns: columns, levelsBack: levelsBack, functions: functions}
+}
+
+// GetInputs returns the number of inputs
+func (config *Config) GetInputs() int {
+	return config.inputs
+}
+
+// GetOutputs returns the number of outputs
+func (config *Config) GetOutputs() int {
+	return config.outputs
+}
+
+// GetNodes returns the number of nodes
+func (config *Config) GetNodes() int {
+	return config.rows * config.columns
+}
+
+// GetFunctions returns the FunctionSet
+func (config *Config) GetFunctions() *FunctionSet {
+	return config.functions
+}
+
+// Len returns the length of the genome
+func (config *Config) Len() int {
+	return config.GetNodes()*config.nodeLen() + config.outputs
+}
+
+// RandomGenes returns a random valid genome
+func (config *Config) RandomGenes() []int {
+	genes := make([]int, config.Len())
+	for i := range genes {
+		genes[i] = config.randomGene(i)
+	}
+	return genes
+}
+
+// IsValidGene returns if value is valid at the position pos of the genome
+func (config *Config) IsValidGene(pos, value int) bool {
+	low, up := config.connectionRange(pos)
+	if config.isFunctionGene(pos) {
+		return value >= 0 && value < config.functions.Len()
+	}
+	return (value >= 0 && value < config.inputs) || (value >= low && value < up)
+}
+
+// nodeLen returns the number of genes of a node
+func (config *Config) nodeLen() int {
+	return config.functions.maxArity + 1
+}
+
+// isFunctionGene returns if the position pos is a function gene
+func (config *Config) isFunctionGene(pos int) bool {
+	return pos < config.GetNodes()*config.nodeLen() && pos%config.nodeLen() == 0
+}
+
+// connectionRange returns the range [low, up) of the node addresses which can be connected by the gene at pos, the inputs can always be connected
+func (config *Config) connectionRange(pos int) (int, int) {
+	nodes := config.GetNodes()
+	if pos >= nodes*config.nodeLen() {
+		return config.inputs, config.inputs + nodes
+	}
+	column := pos / config.nodeLen() / config.rows
+	first := column - config.levelsBack
+	if first < 0 {
+		first = 0
+	}
+	return config.inputs + first*config.rows, config.inputs + column*config.rows
+}
+
+// countGeneValues returns the number of the valid values at pos
+func (config *Config) countGeneValues(pos int) int {
+	if config.isFunctionGene(pos) {
+		return config.functions.Len()
+	}
+	low, up := config.connectionRange(pos)
+	return config.inputs + up - low
+}
+
+// randomGene returns a random valid value at pos
+func (config *Config) randomGene(pos int) int {
+	r := rand.Intn(config.countGeneValues(pos))
+	if config.isFunctionGene(pos) || r < config.inputs {
+		return r
+	}
+	low, _ := config.connectionRange(pos)
+	return low + r - config.inputs
+}
@@ -0,0 +1,110 @@
+package cgp
+
+import (
+	"testing"
+
+	"github.com/sineatos/deag/base"
+)
+
+func newArithmeticConfig() *Config {
+	fs := NewFunctionSet()
+	fs.Add("add", func(args ...interface{}) interface{} { return args[0].(float64) + args[1].(float64) }, 2)
+	fs.Add("neg", func(args ...interface{}) interface{} { return -args[0].(float64) }, 1)
+	return NewConfig(2, 1, 1, 3, 3, fs)
+}
+
+func TestIndividual(t *testing.T) {
+	config := newArithmeticConfig()
+	if config.Len() != 10 {
+		t.Errorf("the length of genome should be 10: %v", config.Len())
+	}
+	// node 0: add(ARG0, ARG1), node 1: neg(node 0), node 2: add(ARG0, ARG0), output: node 1
+	genes := []int{0, 0, 1, 1, 2, 0, 0, 0, 0, 3}
+	ind := NewIndividualFromGenes(config, genes, base.NewFitness([]float64{-1.0}))
+	if y := ind.Evaluate(1.0, 2.0)[0].(float64); y != -3.0 {
+		t.Errorf("the output should be -3: %v", y)
+	}
+	if nodes := ind.ActiveNodes(); len(nodes) != 2 || nodes[0] != 0 || nodes[1] != 1 {
+		t.Errorf("the active nodes should be [0 1]: %v", nodes)
+	}
+	for pos, want := range map[int]bool{0: true, 2: true, 3: true, 4: true, 5: false, 6: false, 9: true} {
+		if ind.IsActiveGene(pos) != want {
+			t.Errorf("the activity of gene %d should be %v", pos, want)
+		}
+	}
+	if exprs := ind.Expressions(); exprs[0] != "neg(add(ARG0, ARG1))" {
+		t.Errorf("wrong expression: %v", exprs)
+	}
+	clone := ind.Clone().(*Individual)
+	if !clone.IsEqual(ind) {
+		t.Errorf("the clone should be equal to the individual: %v, %v", clone, ind)
+	}
+	for _, invalid := range [][]int{
+		{0, 0, 2, 1, 2, 0, 0, 0, 0, 3},
+		{2, 0, 1, 1, 2, 0, 0, 0, 0, 3},
+		{0, 0, 1, 1, 2, 0, 0, 0, 0, 5},
+		{0, 0, 1},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("NewIndividualFromGenes doesn't panic: %v", invalid)
+				}
+			}()
+			NewIndividualFromGenes(config, invalid, base.NewFitness([]float64{-1.0}))
+		}()
+	}
+}
+
+func TestMutation(t *testing.T) {
+	fs := newArithmeticConfig().GetFunctions()
+	// levelsBack 1 only allows the connections to the previous column
+	config := NewConfig(2, 2, 2, 4, 1, fs)
+	for n := 0; n < 100; n++ {
+		ind := NewIndividual(config, base.NewFitness([]float64{-1.0}))
+		old := ind.Clone().(*Individual)
+		MutPoint(ind, 0.2)
+		checkGenes(t, ind)
+		MutSingleActive(ind)
+		checkGenes(t, ind)
+		active := old.activeFlags()
+		changed := false
+		for i, g := range ind.GetChromosome().([]int) {
+			if g != old.GetChromosome().([]int)[i] && old.isActiveGene(active, i) {
+				changed = true
+			}
+		}
+		if !changed && ind.IsEqual(old) {
+			t.Errorf("the mutations should change the individual: %v", ind)
+		}
+		if _, ok := ind.Evaluate(1.0, 2.0)[1].(float64); !ok {
+			t.Error("the output should be float64")
+		}
+	}
+	// MutSingleActive always changes an active gene
+	for n := 0; n < 100; n++ {
+		ind := NewIndividual(config, base.NewFitness([]float64{-1.0}))
+		old := ind.Clone().(*Individual)
+		active := old.activeFlags()
+		MutSingleActive(ind)
+		genes, oldGenes := ind.GetChromosome().([]int), old.GetChromosome().([]int)
+		changed := 0
+		for i := range genes {
+			if genes[i] != oldGenes[i] && old.isActiveGene(active, i) {
+				changed++
+			}
+		}
+		if changed != 1 {
+			t.Errorf("MutSingleActive should change exactly one active gene: %v, %v", old, ind)
+		}
+	}
+}
+
+// checkGenes checks all the genes of ind are valid
+func checkGenes(t *testing.T, ind *Individual) {
+	for i, g := range ind.GetChromosome().([]int) {
+		if !ind.GetConfig().IsValidGene(i, g) {
+			t.Errorf("the gene %d at %d isn't valid: %v", g, i, ind)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package cgp
+
+import (
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/support"
+)
+
+// Evaluator is the type of the evaluator of Individual
+type Evaluator func(ind *Individual) []float64
+
+// OnePlusLambda is the (1+lambda) evolution strategy usually used by CGP [Miller2000].
+// In each generation, lambda offsprings are mutated from the parent, and the best offspring replaces the parent if it isn't worse than the parent.
+// Replacing the parent by an equally good offspring allows the neutral drift through the inactive genes.
+type OnePlusLambda struct {
+	parent     *Individual
+	population base.Individuals
+	lambda     int
+	mutate     Mutation
+	evaluator  Evaluator
+	stat       support.Statistics
+	hof        support.HallOfFame
+	logbook    support.Logbook
+	maxFES     int
+	currentFES int
+	gen        int
+	maxGen     int
+}
+
+// NewOnePlusLambda returns *OnePlusLambda.
+// lambda is the number of offsprings in each generation, the typical value is 4.
+// mutate is the Mutation, such as MutSingleActive.
+// maxGen is maximum running generation.
+// maxFES is maximum function evalutions, maxFES < 0 means maxFES=INF.
+// stat is Statistics, optional.
+// hof is HallOfFame records the best individuals, optional.
+// evaluator is an Evaluator.
+func NewOnePlusLambda(lambda int, mutate Mutation, maxGen, maxFES int, stat support.Statistics, hof support.HallOfFame, evaluator Evaluator) *OnePlusLambda {
+	return &OnePlusLambda{
+		lambda:    lambda,
+		mutate:    mutate,
+		evaluator: evaluator,
+		stat:      stat,
+		hof:       hof,
+		maxFES:    maxFES,
+		maxGen:    maxGen,
+	}
+}
+
+// Init initializes the parent with the best individual of population, all the individuals of population are evaluated
+func (evol *OnePlusLambda) Init(population base.Individuals) {
+	evol.currentFES = 0
+	evol.gen = 0
+	evol.logbook = support.NewDefaultLogbook(evol.maxGen, 0)
+	if evol.hof == nil {
+		evol.hof = support.NewDefaultHallOfFame(1, nil)
+	}
+	evol.population = population
+	evol.parent = nil
+	for _, ind := range population {
+		cInd := ind.(*Individual)
+		cInd.GetFitness().SetValues(evol.evaluator(cInd))
+		if evol.parent == nil || !cInd.GetFitness().Less(evol.parent.GetFitness()) {
+			evol.parent = cInd
+		}
+	}
+	evol.currentFES += population.Len()
+	evol.log(population.Len())
+}
+
+// IsTerminated returns if the evolution is terminated
+func (evol *OnePlusLambda) IsTerminated() (flag bool) {
+	flag = evol.gen >= evol.maxGen
+	flag = flag || (evol.maxFES > 0 && evol.currentFES+evol.lambda > evol.maxFES)
+	return
+}
+
+// Evolve runs the evol a time/generation per call and return generation time
+func (evol *OnePlusLambda) Evolve() interface{} {
+	evol.gen++
+	if !evol.IsTerminated() {
+		offsprings := make(base.Individuals, evol.lambda+1)
+		offsprings[0] = evol.parent
+		var best *Individual
+		for i := 1; i <= evol.lambda; i++ {
+			cInd := evol.mutate(evol.parent.Clone().(*Individual))
+			cInd.GetFitness().SetValues(evol.evaluator(cInd))
+			if best == nil || cInd.GetFitness().Greater(best.GetFitness()) {
+				best = cInd
+			}
+			offsprings[i] = cInd
+		}
+		evol.currentFES += evol.lambda
+		if !best.GetFitness().Less(evol.parent.GetFitness()) {
+			evol.parent = best
+		}
+		evol.population = offsprings
+		evol.log(evol.lambda)
+	}
+	return evol.gen
+}
+
+// Run executes Evolve() until the terminal condition satisfied
+func (evol *OnePlusLambda) Run() {
+	for !evol.IsTerminated() {
+		evol.Evolve()
+	}
+}
+
+// GetParent returns the current parent
+func (evol *OnePlusLambda) GetParent() *Individual {
+	return evol.parent
+}
+
+// GetLogbook returns the logbook saving data
+func (evol *OnePlusLambda) GetLogbook() support.Logbook {
+	return evol.logbook
+}
+
+// GetHallOfFame returns the HallOfFame saving best individuals
+func (evol *OnePlusLambda) GetHallOfFame() support.HallOfFame {
+	return evol.hof
+}
+
+// GetPopulation returns the parent and the offsprings of the last generation
+func (evol *OnePlusLambda) GetPopulation() base.Individuals {
+	return evol.population
+}
+
+// log records the statistics of the population, fes is the number of evaluations in this generation
+func (evol *OnePlusLambda) log(fes int) {
+	var datas support.Dict
+	if evol.stat != nil {
+		datas = evol.stat.Compile(evol.population)
+	} else {
+		datas = make(support.Dict, 3)
+	}
+	datas[support.GEN] = evol.gen
+	datas[support.FES] = fes
+	evol.logbook.Record(datas)
+
+	evol.hof.Update(evol.population)
+}
@@ -0,0 +1,44 @@
+package cgp
+
+import (
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+)
+
+func TestOnePlusLambda(t *testing.T) {
+	fs := NewFunctionSet()
+	fs.Add("and", func(args ...interface{}) interface{} { return args[0].(bool) && args[1].(bool) }, 2)
+	fs.Add("or", func(args ...interface{}) interface{} { return args[0].(bool) || args[1].(bool) }, 2)
+	fs.Add("nand", func(args ...interface{}) interface{} { return !(args[0].(bool) && args[1].(bool)) }, 2)
+	fs.Add("nor", func(args ...interface{}) interface{} { return !(args[0].(bool) || args[1].(bool)) }, 2)
+	config := NewConfig(3, 1, 1, 50, 50, fs)
+	parity := benchmarks.NewParityDataset(3)
+	evaluator := func(ind *Individual) []float64 {
+		hits := parity.Hits(func(bits []bool) bool {
+			return ind.Evaluate(bits[0], bits[1], bits[2])[0].(bool)
+		})
+		return []float64{float64(hits)}
+	}
+	pop := make(base.Individuals, 5)
+	for i := range pop {
+		pop[i] = NewIndividual(config, base.NewFitness([]float64{1.0}))
+	}
+	evol := NewOnePlusLambda(4, MutSingleActive, 5000, -1, nil, nil, evaluator)
+	evol.Init(pop)
+	first := evol.GetParent().GetFitness().GetValues()[0]
+	evol.Run()
+	parent := evol.GetParent()
+	last := parent.GetFitness().GetValues()[0]
+	if last < first || last < 7.0 {
+		t.Errorf("the parent should hit at least 7 cases: %v -> %v", first, last)
+	}
+	if best := evol.GetHallOfFame().Get(0).GetFitness().GetValues()[0]; best != last {
+		t.Errorf("the parent should be the best individual: %v, %v", best, last)
+	}
+	if evol.GetPopulation().Len() != 5 {
+		t.Errorf("the population should be the parent and 4 offsprings: %v", evol.GetPopulation().Len())
+	}
+	t.Logf("hits: %v -> %v, %v active nodes", first, last, len(parent.ActiveNodes()))
+}
@@ -0,0 +1,164 @@
+package cgp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sineatos/deag/base"
+)
+
+// Individual is the individual of CGP, whose chromosome is the integer genome described by Config.
+// It embeds base.IntIndividual, so the genome is an []int and Individual can be used as a base.Individual.
+type Individual struct {
+	base.IntIndividual
+
+	config *Config
+}
+
+// NewIndividual returns *Individual with a random valid genome
+func NewIndividual(config *Config, fitness *base.Fitness) *Individual {
+	return NewIndividualFromGenes(config, config.RandomGenes(), fitness)
+}
+
+// NewIndividualFromGenes returns *Individual whose genome is genes, it panics if genes isn't valid
+func NewIndividualFromGenes(config *Config, genes []int, fitness *base.Fitness) *Individual {
+	if len(genes) != config.Len() {
+		panic(fmt.Sprintf("The length of genes should be %d: %d", config.Len(), len(genes)))
+	}
+	for i, g := range genes {
+		if !config.IsValidGene(i, g) {
+			panic(fmt.Sprintf("The gene %d at %d isn't valid: %v", g, i, genes))
+		}
+	}
+	return &Individual{IntIndividual: *base.NewIntIndividual(genes, fitness), config: config}
+}
+
+// Clone returns an copy of Individual
+func (ind *Individual) Clone() interface{} {
+	iInd := ind.IntIndividual.Clone().(*base.IntIndividual)
+	return &Individual{IntIndividual: *iInd, config: ind.config}
+}
+
+// IsEqual returns if the genome of other is equal to the individual's
+func (ind *Individual) IsEqual(other base.Individual) bool {
+	if otherInd, ok := other.(*Individual); ok {
+		return ind.IntIndividual.IsEqual(&otherInd.IntIndividual)
+	}
+	return ind.IntIndividual.IsEqual(other)
+}
+
+func (ind *Individual) String() string {
+	return fmt.Sprintf("cgp.Individual{IntIndividual:%v, expressions:[%s]}", ind.IntIndividual.String(), strings.Join(ind.Expressions(), ", "))
+}
+
+// GetConfig returns the Config of the individual
+func (ind *Individual) GetConfig() *Config {
+	return ind.config
+}
+
+// ActiveNodes returns the indexes of the nodes used by the outputs in increasing order
+func (ind *Individual) ActiveNodes() []int {
+	active := ind.activeFlags()
+	nodes := make([]int, 0)
+	for i, flag := range active {
+		if flag {
+			nodes = append(nodes, i)
+		}
+	}
+	return nodes
+}
+
+// IsActiveGene returns if the gene at pos affects the outputs, it is an output gene,
+// or the function gene or one of the first arity connection genes of an active node.
+func (ind *Individual) IsActiveGene(pos int) bool {
+	return ind.isActiveGene(ind.activeFlags(), pos)
+}
+
+// Evaluate returns the outputs of the decoded graph with inputs, only the active nodes are computed
+func (ind *Individual) Evaluate(inputs ...interface{}) []interface{} {
+	config := ind.config
+	if len(inputs) != config.inputs {
+		panic(fmt.Sprintf("The graph takes %d inputs, but %d are given", config.inputs, len(inputs)))
+	}
+	genes := ind.GetChromosome().([]int)
+	values := make([]interface{}, config.inputs+config.GetNodes())
+	copy(values, inputs)
+	for _, i := range ind.ActiveNodes() {
+		begin := i * config.nodeLen()
+		f := genes[begin]
+		args := make([]interface{}, config.functions.arities[f])
+		for j := range args {
+			args[j] = values[genes[begin+1+j]]
+		}
+		values[config.inputs+i] = config.functions.fns[f](args...)
+	}
+	outputs := make([]interface{}, config.outputs)
+	for o := range outputs {
+		outputs[o] = values[genes[config.GetNodes()*config.nodeLen()+o]]
+	}
+	return outputs
+}
+
+// Expressions returns the prefix expressions of the outputs, the inputs are named ARG0, ARG1, ...
+func (ind *Individual) Expressions() []string {
+	config := ind.config
+	genes := ind.GetChromosome().([]int)
+	var expr func(address int) string
+	expr = func(address int) string {
+		if address < config.inputs {
+			return fmt.Sprintf("ARG%d", address)
+		}
+		begin := (address - config.inputs) * config.nodeLen()
+		f := genes[begin]
+		args := make([]string, config.functions.arities[f])
+		for j := range args {
+			args[j] = expr(genes[begin+1+j])
+		}
+		return fmt.Sprintf("%s(%s)", config.functions.names[f], strings.Join(args, ", "))
+	}
+	exprs := make([]string, config.outputs)
+	for o := range exprs {
+		exprs[o] = expr(genes[config.GetNodes()*config.nodeLen()+o])
+	}
+	return exprs
+}
+
+// activeFlags returns if each node is active
+func (ind *Individual) activeFlags() []bool {
+	config := ind.config
+	genes := ind.GetChromosome().([]int)
+	nodes := config.GetNodes()
+	active := make([]bool, nodes)
+	stack := make([]int, 0, nodes)
+	for o := 0; o < config.outputs; o++ {
+		stack = append(stack, genes[nodes*config.nodeLen()+o])
+	}
+	for len(stack) > 0 {
+		address := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if address < config.inputs || active[address-config.inputs] {
+			continue
+		}
+		i := address - config.inputs
+		active[i] = true
+		begin := i * config.nodeLen()
+		for j := 0; j < config.functions.arities[genes[begin]]; j++ {
+			stack = append(stack, genes[begin+1+j])
+		}
+	}
+	return active
+}
+
+// isActiveGene returns if the gene at pos is active with the active nodes
+func (ind *Individual) isActiveGene(active []bool, pos int) bool {
+	config := ind.config
+	if pos >= config.GetNodes()*config.nodeLen() {
+		return true
+	}
+	i := pos / config.nodeLen()
+	if !active[i] {
+		return false
+	}
+	offset := pos % config.nodeLen()
+	return offset == 0 || offset <= config.functions.arities[ind.GetChromosome().([]int)[i*config.nodeLen()]]
+}
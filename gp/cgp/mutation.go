@@ -0,0 +1,51 @@
+package cgp
+
+import (
+	"math/rand"
+)
+
+// Mutation is the type of the mutation operators of Individual, the individual is modified in place
+type Mutation func(ind *Individual) *Individual
+
+// MutPoint changes each gene with the probability indpb to another valid value,
+// a function gene gets another function and a connection gene gets another address allowed by levelsBack.
+func MutPoint(ind *Individual, indpb float64) *Individual {
+	genes := ind.GetChromosome().([]int)
+	for i := range genes {
+		if rand.Float64() < indpb {
+			genes[i] = mutateGene(ind.config, i, genes[i])
+		}
+	}
+	return ind
+}
+
+// MutSingleActive changes random genes to other valid values until an active gene is changed [Goldman2013],
+// the inactive genes changed before it are neutral. The connection genes after the arity of the function of a node are inactive.
+//
+// [Goldman2013] Goldman and Punch, "Reducing wasted evaluations in cartesian genetic programming", 2013.
+func MutSingleActive(ind *Individual) *Individual {
+	genes := ind.GetChromosome().([]int)
+	active := ind.activeFlags()
+	for {
+		pos := rand.Intn(len(genes))
+		if ind.config.countGeneValues(pos) < 2 {
+			continue
+		}
+		genes[pos] = mutateGene(ind.config, pos, genes[pos])
+		if ind.isActiveGene(active, pos) {
+			return ind
+		}
+	}
+}
+
+// mutateGene returns another valid value at pos, it returns value if there is no other value
+func mutateGene(config *Config, pos, value int) int {
+	if config.countGeneValues(pos) < 2 {
+		return value
+	}
+	for {
+		if v := config.randomGene(pos); v != value {
+			return v
+		}
+	}
+}
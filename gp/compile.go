@@ -0,0 +1,92 @@
+package gp
+
+import (
+	"fmt"
+)
+
+// Compile returns the Function computing the expression nodes, the arguments of the Function are the arguments of pset.
+// The Function calls the function of each Primitive with the values of its children.
+// The expressions containing ADF primitives should be compiled by CompileADF.
+func Compile(nodes []Node, pset *PrimitiveSet) Function {
+	return compileWithADFs(nodes, pset, nil)
+}
+
+// CompileTree returns the Function computing the tree, see Compile
+func CompileTree(tree *Tree, pset *PrimitiveSet) Function {
+	return Compile(tree.nodes, pset)
+}
+
+// CompileADF returns the Function computing the main tree (the first tree) of ind, psets[i] is the PrimitiveSet of the i-th tree.
+// The trees are compiled in reverse order, so a tree can only call the ADFs defined by the trees after it.
+func CompileADF(ind *MultiTree, psets []*PrimitiveSet) Function {
+	if len(psets) != len(ind.trees) {
+		panic(fmt.Sprintf("The number of PrimitiveSets should be the number of trees %d: %d", len(ind.trees), len(psets)))
+	}
+	adfs := make(map[string]Function)
+	var fn Function
+	for i := len(psets) - 1; i >= 0; i-- {
+		fn = compileWithADFs(ind.trees[i].nodes, psets[i], adfs)
+		adfs[psets[i].name] = fn
+	}
+	return fn
+}
+
+// compileWithADFs compiles nodes like Compile, the ADF primitives are bound to the functions in adfs
+func compileWithADFs(nodes []Node, pset *PrimitiveSet, adfs map[string]Function) Function {
+	if len(nodes) == 0 {
+		panic("Cannot compile an empty expression")
+	}
+	fn, end := compile(nodes, 0, adfs)
+	if end != len(nodes) {
+		panic(fmt.Sprintf("The expression isn't a valid tree: %v", nodes))
+	}
+	arity := len(pset.arguments)
+	return func(args ...interface{}) interface{} {
+		if len(args) != arity {
+			panic(fmt.Sprintf("The function compiled from %s takes %d arguments, but %d are given", pset.name, arity, len(args)))
+		}
+		return fn(args)
+	}
+}
+
+// compile returns the function computing the subtree whose root is at begin and the end (exclusive) of the subtree
+func compile(nodes []Node, begin int, adfs map[string]Function) (func(args []interface{}) interface{}, int) {
+	if begin >= len(nodes) {
+		panic(fmt.Sprintf("The expression isn't a valid tree: %v", nodes))
+	}
+	switch node := nodes[begin].(type) {
+	case *Primitive:
+		children := make([]func(args []interface{}) interface{}, node.GetArity())
+		end := begin + 1
+		for i := range children {
+			children[i], end = compile(nodes, end, adfs)
+		}
+		fn := node.fn
+		if node.adf {
+			adf, ok := adfs[node.name]
+			if !ok {
+				panic(fmt.Sprintf("The ADF %s isn't compiled, it should be defined by a tree after the trees calling it", node.name))
+			}
+			fn = adf
+		}
+		return func(args []interface{}) interface{} {
+			values := make([]interface{}, len(children))
+			for i, child := range children {
+				values[i] = child(args)
+			}
+			return fn(values...)
+		}, end
+	case *Terminal:
+		value := node.value
+		return func(args []interface{}) interface{} {
+			return value
+		}, begin + 1
+	case *Argument:
+		index := node.index
+		return func(args []interface{}) interface{} {
+			return args[index]
+		}, begin + 1
+	default:
+		panic(fmt.Sprintf("Cannot compile the node %v", node))
+	}
+}
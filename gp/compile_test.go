@@ -0,0 +1,33 @@
+package gp
+
+import (
+	"testing"
+
+	"github.com/sineatos/deag/base"
+)
+
+func TestCompile(t *testing.T) {
+	pset := newArithmeticPrimitiveSet()
+	tree := NewTreeFromString("add(x, mul(neg(x), 3.0))", pset, base.NewFitness([]float64{-1.0}))
+	fn := CompileTree(tree, pset)
+	for _, x := range []float64{-2.0, 0.0, 1.5} {
+		if y := fn(x).(float64); y != x-3.0*x {
+			t.Errorf("f(%v) should be %v: %v", x, x-3.0*x, y)
+		}
+	}
+	typed := newTypedPrimitiveSet()
+	tree = NewTreeFromString("if_then_else(lt(ARG0, ARG1), ARG1, add(ARG0, 1.5))", typed, base.NewFitness([]float64{1.0}))
+	fn = CompileTree(tree, typed)
+	if y := fn(1.0, 2.0).(float64); y != 2.0 {
+		t.Errorf("f(1, 2) should be 2: %v", y)
+	}
+	if y := fn(3.0, 2.0).(float64); y != 4.5 {
+		t.Errorf("f(3, 2) should be 4.5: %v", y)
+	}
+	for n := 0; n < 100; n++ {
+		fn := Compile(GenHalfAndHalf(typed, 1, 4), typed)
+		if _, ok := fn(1.0, 2.0).(float64); !ok {
+			t.Error("the compiled function should return float64")
+		}
+	}
+}
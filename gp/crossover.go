@@ -0,0 +1,90 @@
+package gp
+
+import (
+	"math/rand"
+)
+
+// Crossover is the type of the crossover operators of Tree, the trees are modified in place
+type Crossover func(ind1, ind2 *Tree) (*Tree, *Tree)
+
+// CxOnePoint randomly selects a subtree in each individual and exchanges them, the roots of the subtrees return the same type.
+// The roots of the trees are never selected, the trees are not changed if there is no such pair of subtrees.
+func CxOnePoint(ind1, ind2 *Tree) (*Tree, *Tree) {
+	return cxOnePoint(ind1, ind2, func(node Node) bool { return true })
+}
+
+// CxOnePointLeafBiased randomly selects a subtree in each individual and exchanges them like CxOnePoint,
+// a terminal is selected with the probability termpb and a primitive is selected otherwise (Koza's 90% internal node selection uses termpb=0.1).
+// If one of the trees has no primitive (or terminal) to select, the selection in both trees falls back to all the nodes.
+func CxOnePointLeafBiased(ind1, ind2 *Tree, termpb float64) (*Tree, *Tree) {
+	if ind1.Len() < 2 || ind2.Len() < 2 {
+		return ind1, ind2
+	}
+	// the terminals are selected in both trees with the same decision
+	terminalOp := func(node Node) bool { return node.GetArity() == 0 }
+	primitiveOp := func(node Node) bool { return node.GetArity() > 0 }
+	accept := primitiveOp
+	if rand.Float64() < termpb {
+		accept = terminalOp
+	}
+	if len(collectTypes(ind1.nodes, accept)) == 0 || len(collectTypes(ind2.nodes, accept)) == 0 {
+		accept = func(node Node) bool { return true }
+	}
+	return cxOnePoint(ind1, ind2, accept)
+}
+
+// cxOnePoint exchanges a pair of subtrees whose roots are accepted
+func cxOnePoint(ind1, ind2 *Tree, accept func(Node) bool) (*Tree, *Tree) {
+	if ind1.Len() < 2 || ind2.Len() < 2 {
+		return ind1, ind2
+	}
+	types1, types2 := collectTypes(ind1.nodes, accept), collectTypes(ind2.nodes, accept)
+	common := make([]Type, 0, len(types1))
+	for t := range types1 {
+		if _, ok := types2[t]; ok {
+			common = append(common, t)
+		}
+	}
+	if len(common) == 0 {
+		return ind1, ind2
+	}
+	// sort the types to make the choice reproducible with the same seed
+	sortTypes(common)
+	t := common[rand.Intn(len(common))]
+	index1 := types1[t][rand.Intn(len(types1[t]))]
+	index2 := types2[t][rand.Intn(len(types2[t]))]
+	end1, end2 := searchSubtree(ind1.nodes, index1), searchSubtree(ind2.nodes, index2)
+	sub1 := ind1.nodes[index1:end1]
+	sub2 := ind2.nodes[index2:end2]
+	ind1.nodes, ind2.nodes = replaceNodes(ind1.nodes, index1, end1, sub2), replaceNodes(ind2.nodes, index2, end2, sub1)
+	return ind1, ind2
+}
+
+// collectTypes returns the indexes (except the root) of the accepted nodes grouped by their types
+func collectTypes(nodes []Node, accept func(Node) bool) map[Type][]int {
+	types := make(map[Type][]int)
+	for i, node := range nodes[1:] {
+		if accept(node) {
+			types[node.GetRet()] = append(types[node.GetRet()], i+1)
+		}
+	}
+	return types
+}
+
+// sortTypes sorts types in place by insertion sort, the number of types is usually small
+func sortTypes(types []Type) {
+	for i := 1; i < len(types); i++ {
+		for j := i; j > 0 && types[j] < types[j-1]; j-- {
+			types[j], types[j-1] = types[j-1], types[j]
+		}
+	}
+}
+
+// replaceNodes returns a new slice where nodes[begin:end] is replaced by sub
+func replaceNodes(nodes []Node, begin, end int, sub []Node) []Node {
+	ans := make([]Node, 0, len(nodes)-(end-begin)+len(sub))
+	ans = append(ans, nodes[:begin]...)
+	ans = append(ans, sub...)
+	ans = append(ans, nodes[end:]...)
+	return ans
+}
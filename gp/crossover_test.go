@@ -0,0 +1,27 @@
+package gp
+
+import (
+	"testing"
+)
+
+func TestCrossover(t *testing.T) {
+	psets := []*PrimitiveSet{newArithmeticPrimitiveSet(), newTypedPrimitiveSet()}
+	for _, pset := range psets {
+		for n := 0; n < 100; n++ {
+			ind1, ind2 := NewTree(GenFull(pset, 2, 4), nil), NewTree(GenGrow(pset, 1, 4), nil)
+			total := ind1.Len() + ind2.Len()
+			ind1, ind2 = CxOnePoint(ind1, ind2)
+			if ind1.Len()+ind2.Len() != total {
+				t.Errorf("the number of nodes is changed by CxOnePoint: %v, %v", ind1, ind2)
+			}
+			checkTypes(t, ind1.nodes, pset.GetRet())
+			checkTypes(t, ind2.nodes, pset.GetRet())
+			ind1, ind2 = CxOnePointLeafBiased(ind1, ind2, 0.1)
+			if ind1.Len()+ind2.Len() != total {
+				t.Errorf("the number of nodes is changed by CxOnePointLeafBiased: %v, %v", ind1, ind2)
+			}
+			checkTypes(t, ind1.nodes, pset.GetRet())
+			checkTypes(t, ind2.nodes, pset.GetRet())
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package gp
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Condition decides whether a terminal should be chosen at depth, height is the height of the tree to be generated
+type Condition func(height, depth int) bool
+
+// GenFull returns an expression where each leaf has the same depth between min and max (inclusive)
+func GenFull(pset *PrimitiveSet, min, max int) []Node {
+	return Generate(pset, min, max, FullCondition, pset.ret)
+}
+
+// GenGrow returns an expression where each leaf might have a different depth between min and max (inclusive)
+func GenGrow(pset *PrimitiveSet, min, max int) []Node {
+	return Generate(pset, min, max, GrowCondition(pset, min), pset.ret)
+}
+
+// GenHalfAndHalf returns an expression generated by GenFull or GenGrow with the same probability
+func GenHalfAndHalf(pset *PrimitiveSet, min, max int) []Node {
+	if rand.Float64() < 0.5 {
+		return GenFull(pset, min, max)
+	}
+	return GenGrow(pset, min, max)
+}
+
+// FullCondition chooses the terminals only at the maximum depth
+func FullCondition(height, depth int) bool {
+	return depth == height
+}
+
+// GrowCondition returns the Condition choosing the terminals at the maximum depth,
+// or with the probability pset.TerminalRatio() when the depth is not less than min
+func GrowCondition(pset *PrimitiveSet, min int) Condition {
+	ratio := pset.TerminalRatio()
+	return func(height, depth int) bool {
+		return depth == height || (depth >= min && rand.Float64() < ratio)
+	}
+}
+
+// Generate returns an expression whose root returns type ret and whose height is a random value between min and max (inclusive),
+// a terminal is chosen at the depth where condition is true, otherwise a primitive is chosen.
+// It panics if there is no node of the required type.
+func Generate(pset *PrimitiveSet, min, max int, condition Condition, ret Type) []Node {
+	height := min + rand.Intn(max-min+1)
+	expr := make([]Node, 0)
+	type frame struct {
+		depth int
+		ret   Type
+	}
+	stack := []frame{{0, ret}}
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if condition(height, top.depth) {
+			terms := pset.GetTerminals(top.ret)
+			if len(terms) == 0 {
+				panic(fmt.Sprintf("The gp.Generate function tried to add a terminal of type %s, but there is none available", top.ret))
+			}
+			expr = append(expr, instantiate(terms[rand.Intn(len(terms))]))
+		} else {
+			prims := pset.GetPrimitives(top.ret)
+			if len(prims) == 0 {
+				panic(fmt.Sprintf("The gp.Generate function tried to add a primitive of type %s, but there is none available", top.ret))
+			}
+			prim := prims[rand.Intn(len(prims))]
+			expr = append(expr, prim)
+			for i := prim.GetArity() - 1; i >= 0; i-- {
+				stack = append(stack, frame{top.depth + 1, prim.args[i]})
+			}
+		}
+	}
+	return expr
+}
+
+// instantiate returns a new Terminal generated by node if node is an Ephemeral, otherwise returns node
+func instantiate(node Node) Node {
+	if eph, ok := node.(*Ephemeral); ok {
+		return eph.Generate()
+	}
+	return node
+}
@@ -0,0 +1,61 @@
+package gp
+
+import (
+	"testing"
+)
+
+// checkTypes checks the types of the arguments of each primitive in expr
+func checkTypes(t *testing.T, expr []Node, ret Type) {
+	types := []Type{ret}
+	for _, node := range expr {
+		want := types[len(types)-1]
+		types = types[:len(types)-1]
+		if node.GetRet() != want {
+			t.Errorf("the type of %v should be %v: %v", node, want, format(expr, false))
+		}
+		if prim, ok := node.(*Primitive); ok {
+			for i := prim.GetArity() - 1; i >= 0; i-- {
+				types = append(types, prim.GetArgs()[i])
+			}
+		}
+	}
+	if len(types) != 0 {
+		t.Errorf("the expression isn't complete: %v", format(expr, false))
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	psets := []*PrimitiveSet{newArithmeticPrimitiveSet(), newTypedPrimitiveSet()}
+	for _, pset := range psets {
+		for n := 0; n < 100; n++ {
+			expr := GenFull(pset, 1, 3)
+			height := NewTree(expr, nil).Height()
+			if height < 1 || height > 3 {
+				t.Errorf("the height of full tree should be in [1, 3]: %v", height)
+			}
+			checkTypes(t, expr, pset.GetRet())
+			expr = GenGrow(pset, 0, 4)
+			if height := NewTree(expr, nil).Height(); height > 4 {
+				t.Errorf("the height of grown tree should be in [0, 4]: %v", height)
+			}
+			checkTypes(t, expr, pset.GetRet())
+			checkTypes(t, GenHalfAndHalf(pset, 2, 4), pset.GetRet())
+		}
+	}
+	// all the leaves of a full untyped tree have the same depth
+	pset := newArithmeticPrimitiveSet()
+	for n := 0; n < 100; n++ {
+		expr := GenFull(pset, 3, 3)
+		stack := []int{0}
+		for _, node := range expr {
+			depth := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if node.GetArity() == 0 && depth != 3 {
+				t.Errorf("the leaf %v is at depth %d: %v", node, depth, format(expr, false))
+			}
+			for i := 0; i < node.GetArity(); i++ {
+				stack = append(stack, depth+1)
+			}
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package grammar
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Symbol is a symbol of a production, it is a terminal or a nonterminal named by the text between < and >
+type Symbol struct {
+	value       string
+	nonterminal bool
+}
+
+// GetValue returns the text of a terminal or the name (without < and >) of a nonterminal
+func (sym Symbol) GetValue() string {
+	return sym.value
+}
+
+// IsNonterminal returns if the symbol is a nonterminal
+func (sym Symbol) IsNonterminal() bool {
+	return sym.nonterminal
+}
+
+func (sym Symbol) String() string {
+	if sym.nonterminal {
+		return "<" + sym.value + ">"
+	}
+	return sym.value
+}
+
+// Production is a choice of a rule, which is a sequence of symbols
+type Production []Symbol
+
+// Grammar is a context free grammar, the first rule of the BNF text is the start rule
+type Grammar struct {
+	start string
+	names []string
+	rules map[string][]Production
+}
+
+// ParseBNF returns *Grammar parsed from the BNF text, such as
+//
+//	<expr> ::= <expr> <op> <expr> | ( <expr> ) | <var>
+//	<op>   ::= + | - | *
+//	<var>  ::= x | 1.0
+//
+// A rule can be continued on the next lines, the lines starting with # are comments.
+// The choices are separated by |, the text between the nonterminals is a terminal, whose spaces are kept except the leading and trailing spaces of the choice.
+// A terminal can be quoted by " or ' to contain |, < or the leading and trailing spaces, "" is the empty string.
+// ParseBNF panics if the text isn't valid or a nonterminal has no rule.
+func ParseBNF(text string) *Grammar {
+	g := &Grammar{rules: make(map[string][]Production)}
+	name, body := "", ""
+	flush := func() {
+		if name != "" {
+			g.addRule(name, body)
+		}
+	}
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if index := strings.Index(trimmed, "::="); index >= 0 && strings.HasPrefix(trimmed, "<") {
+			flush()
+			lhs := strings.TrimSpace(trimmed[:index])
+			if len(lhs) < 3 || !strings.HasSuffix(lhs, ">") || strings.ContainsAny(lhs[1:len(lhs)-1], "<> ") {
+				panic(fmt.Sprintf("Invalid nonterminal on the left side of the rule: %s", line))
+			}
+			name, body = lhs[1:len(lhs)-1], trimmed[index+3:]
+			continue
+		}
+		if name == "" {
+			panic(fmt.Sprintf("The line doesn't belong to any rule: %s", line))
+		}
+		body += " " + trimmed
+	}
+	flush()
+	if g.start == "" {
+		panic("There is no rule in the grammar")
+	}
+	for _, n := range g.names {
+		for _, prod := range g.rules[n] {
+			for _, sym := range prod {
+				if _, ok := g.rules[sym.value]; sym.nonterminal && !ok {
+					panic(fmt.Sprintf("The nonterminal %v used by <%s> has no rule", sym, n))
+				}
+			}
+		}
+	}
+	return g
+}
+
+// GetStart returns the name of the start nonterminal
+func (g *Grammar) GetStart() string {
+	return g.start
+}
+
+// GetNonterminals returns the names of the nonterminals in the order of their rules
+func (g *Grammar) GetNonterminals() []string {
+	names := make([]string, len(g.names))
+	copy(names, g.names)
+	return names
+}
+
+// GetProductions returns the choices of the nonterminal named name (not a copy)
+func (g *Grammar) GetProductions(name string) []Production {
+	return g.rules[name]
+}
+
+// String returns the BNF representation of the grammar
+func (g *Grammar) String() string {
+	lines := make([]string, len(g.names))
+	for i, n := range g.names {
+		choices := make([]string, len(g.rules[n]))
+		for j, prod := range g.rules[n] {
+			syms := make([]string, len(prod))
+			for k, sym := range prod {
+				if sym.nonterminal {
+					syms[k] = sym.String()
+				} else {
+					syms[k] = fmt.Sprintf("%q", sym.value)
+				}
+			}
+			choices[j] = strings.Join(syms, "")
+			if len(prod) == 0 {
+				choices[j] = `""`
+			}
+		}
+		lines[i] = fmt.Sprintf("<%s> ::= %s", n, strings.Join(choices, " | "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// addRule parses the choices in body and adds them to the rule of the nonterminal name, the choices are appended if the rule exists
+func (g *Grammar) addRule(name, body string) {
+	if _, ok := g.rules[name]; !ok {
+		g.names = append(g.names, name)
+		if g.start == "" {
+			g.start = name
+		}
+	}
+	for _, choice := range splitChoices(name, body) {
+		prod := Production{}
+		text := ""
+		flush := func() {
+			if text != "" {
+				prod = append(prod, Symbol{value: text})
+			}
+			text = ""
+		}
+		runes := []rune(strings.TrimSpace(choice))
+		for i := 0; i < len(runes); i++ {
+			switch r := runes[i]; r {
+			case '"', '\'':
+				end := i + 1
+				for end < len(runes) && runes[end] != r {
+					end++
+				}
+				text += string(runes[i+1 : end])
+				i = end
+			case '<':
+				end := i + 1
+				for end < len(runes) && runes[end] != '>' {
+					end++
+				}
+				if end == len(runes) || end == i+1 || strings.ContainsAny(string(runes[i+1:end]), "< ") {
+					panic(fmt.Sprintf("Invalid nonterminal in the rule of <%s>: %s", name, body))
+				}
+				flush()
+				prod = append(prod, Symbol{value: string(runes[i+1 : end]), nonterminal: true})
+				i = end
+			default:
+				text += string(r)
+			}
+		}
+		flush()
+		g.rules[name] = append(g.rules[name], prod)
+	}
+}
+
+// splitChoices splits body by the | out of the quotes
+func splitChoices(name, body string) []string {
+	choices := make([]string, 0)
+	var quote rune
+	begin := 0
+	for i, r := range body {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '|':
+			choices = append(choices, body[begin:i])
+			begin = i + 1
+		}
+	}
+	if quote != 0 {
+		panic(fmt.Sprintf("Unclosed quote in the rule of <%s>: %s", name, body))
+	}
+	return append(choices, body[begin:])
+}
@@ -0,0 +1,62 @@
+package grammar
+
+import (
+	"testing"
+)
+
+const arithmeticBNF = `
+# arithmetic expressions of x
+<expr> ::= <expr><op><expr>
+         | (<expr>)
+         | <var>
+<op>   ::= + | - | *
+<var>  ::= x | 1.0
+`
+
+func TestParseBNF(t *testing.T) {
+	g := ParseBNF(arithmeticBNF)
+	if g.GetStart() != "expr" {
+		t.Errorf("the start nonterminal should be expr: %v", g.GetStart())
+	}
+	if names := g.GetNonterminals(); len(names) != 3 || names[1] != "op" {
+		t.Errorf("wrong nonterminals: %v", names)
+	}
+	prods := g.GetProductions("expr")
+	if len(prods) != 3 || len(prods[0]) != 3 || !prods[0][1].IsNonterminal() || prods[0][1].GetValue() != "op" {
+		t.Errorf("wrong productions of expr: %v", prods)
+	}
+	if len(prods[1]) != 3 || prods[1][0].IsNonterminal() || prods[1][0].GetValue() != "(" {
+		t.Errorf("wrong production (<expr>): %v", prods[1])
+	}
+	g = ParseBNF(`<s> ::= <a> <a> | "x|y" | ' '<s> | ""
+<a> ::= a`)
+	prods = g.GetProductions("s")
+	if len(prods) != 4 {
+		t.Fatalf("s should have 4 productions: %v", prods)
+	}
+	if len(prods[0]) != 3 || prods[0][1].GetValue() != " " {
+		t.Errorf("the space between the nonterminals should be a terminal: %v", prods[0])
+	}
+	if len(prods[1]) != 1 || prods[1][0].GetValue() != "x|y" {
+		t.Errorf("the quoted | should be kept: %v", prods[1])
+	}
+	if len(prods[2]) != 2 || prods[2][0].GetValue() != " " {
+		t.Errorf("the quoted space should be kept: %v", prods[2])
+	}
+	if len(prods[3]) != 0 {
+		t.Errorf("the empty string should be an empty production: %v", prods[3])
+	}
+	if str := ParseBNF(g.String()).String(); str != g.String() {
+		t.Errorf("the BNF representation should be parsed to the same grammar: %v, %v", str, g.String())
+	}
+	for _, text := range []string{"", "<a> ::= <b>", "<a> ::= \"x", "x ::= y", "<a> ::= <b\n<b> ::= b", "y\n<a> ::= a"} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("ParseBNF doesn't panic: %q", text)
+				}
+			}()
+			ParseBNF(text)
+		}()
+	}
+}
@@ -0,0 +1,119 @@
+package grammar
+
+import (
+	"strings"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+)
+
+// Phenotype is the result of mapping a genome by a Grammar
+type Phenotype struct {
+	output string
+	valid  bool
+	used   int
+	wraps  int
+	depth  int
+}
+
+// GetOutput returns the program derived from the genome, it is the partial derivation if the phenotype is invalid
+func (ph *Phenotype) GetOutput() string {
+	return ph.output
+}
+
+// IsValid returns if the derivation is complete
+func (ph *Phenotype) IsValid() bool {
+	return ph.valid
+}
+
+// GetUsedCodons returns the number of the codons used by the mapping, the wrapped codons are counted again
+func (ph *Phenotype) GetUsedCodons() int {
+	return ph.used
+}
+
+// GetWraps returns the number of times the genome is wrapped
+func (ph *Phenotype) GetWraps() int {
+	return ph.wraps
+}
+
+// GetDepth returns the depth of the derivation tree, the start nonterminal is at depth 0
+func (ph *Phenotype) GetDepth() int {
+	return ph.depth
+}
+
+// Map maps genome to a program by the leftmost derivation from the start nonterminal [ONeill2001].
+// The leftmost nonterminal is replaced by the choice codon % n of its n choices, and a codon is used only if n > 1.
+// The genome is read again from the beginning (wrapping) when all the codons are used, at most maxWraps times.
+// The phenotype is invalid if more wraps are needed or a nonterminal deeper than maxDepth should be expanded, maxDepth <= 0 means no limit.
+//
+// [ONeill2001] O'Neill and Ryan, "Grammatical evolution", 2001.
+func (g *Grammar) Map(genome []int, maxWraps, maxDepth int) *Phenotype {
+	type item struct {
+		sym   Symbol
+		depth int
+	}
+	ph := &Phenotype{}
+	var output strings.Builder
+	stack := []item{{sym: Symbol{value: g.start, nonterminal: true}}}
+	index := 0
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !top.sym.nonterminal {
+			output.WriteString(top.sym.value)
+			continue
+		}
+		if maxDepth > 0 && top.depth > maxDepth {
+			ph.output = output.String()
+			return ph
+		}
+		if top.depth > ph.depth {
+			ph.depth = top.depth
+		}
+		prods := g.rules[top.sym.value]
+		choice := 0
+		if len(prods) > 1 {
+			if index == len(genome) {
+				if len(genome) == 0 || ph.wraps == maxWraps {
+					ph.output = output.String()
+					return ph
+				}
+				ph.wraps++
+				index = 0
+			}
+			choice = genome[index] % len(prods)
+			if choice < 0 {
+				choice += len(prods)
+			}
+			index++
+			ph.used++
+		}
+		prod := prods[choice]
+		for i := len(prod) - 1; i >= 0; i-- {
+			stack = append(stack, item{sym: prod[i], depth: top.depth + 1})
+		}
+	}
+	ph.output = output.String()
+	ph.valid = true
+	return ph
+}
+
+// MapIndividual maps the chromosome of ind, see Map
+func (g *Grammar) MapIndividual(ind *base.IntIndividual, maxWraps, maxDepth int) *Phenotype {
+	return g.Map(ind.GetChromosome().([]int), maxWraps, maxDepth)
+}
+
+// NewEvaluator returns an IntEvaluator which maps the individual by g and evaluates the program by evaluate,
+// the invalid individuals get a copy of invalid (usually the worst values) without calling evaluate.
+// The IntIndividuals can be evolved by the integer operators such as crossover.CxOnePointInt and mutation.MutUniformInt.
+func NewEvaluator(g *Grammar, maxWraps, maxDepth int, evaluate func(program string) []float64, invalid []float64) benchmarks.IntEvaluator {
+	return func(ind *base.IntIndividual) []float64 {
+		ph := g.MapIndividual(ind, maxWraps, maxDepth)
+		if !ph.IsValid() {
+			values := make([]float64, len(invalid))
+			copy(values, invalid)
+			return values
+		}
+		return evaluate(ph.GetOutput())
+	}
+}
@@ -0,0 +1,95 @@
+package grammar
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/crossover"
+	"github.com/sineatos/deag/tools/inits"
+	"github.com/sineatos/deag/tools/mutation"
+	"github.com/sineatos/deag/tools/selection"
+)
+
+func TestMap(t *testing.T) {
+	g := ParseBNF(arithmeticBNF)
+	ph := g.Map([]int{0, 2, 0, 1, 2, 1}, 0, 0)
+	if !ph.IsValid() || ph.GetOutput() != "x-1.0" {
+		t.Errorf("the phenotype should be x-1.0: %v, %v", ph.IsValid(), ph.GetOutput())
+	}
+	if ph.GetUsedCodons() != 6 || ph.GetWraps() != 0 || ph.GetDepth() != 2 {
+		t.Errorf("wrong used codons, wraps or depth: %v, %v, %v", ph.GetUsedCodons(), ph.GetWraps(), ph.GetDepth())
+	}
+	// the codons are read again after wrapping
+	ph = g.Map([]int{3, 5, 4}, 1, 0)
+	if !ph.IsValid() || ph.GetOutput() != "x+x" || ph.GetWraps() != 1 || ph.GetUsedCodons() != 6 {
+		t.Errorf("the phenotype should be x+x with 1 wrap: %v, %v, %v", ph.IsValid(), ph.GetOutput(), ph.GetWraps())
+	}
+	if ph = g.Map([]int{3, 5, 4}, 0, 0); ph.IsValid() {
+		t.Errorf("the phenotype should be invalid without wrapping: %v", ph.GetOutput())
+	}
+	// the derivation never ends with the codon 0
+	if ph = g.Map([]int{0}, 1000, 5); ph.IsValid() || ph.GetDepth() != 5 {
+		t.Errorf("the phenotype should be invalid at depth 5: %v, %v", ph.IsValid(), ph.GetDepth())
+	}
+	if ph = g.Map([]int{-1, -3, -2}, 0, 0); !ph.IsValid() || ph.GetOutput() != "1.0" {
+		t.Errorf("the negative codons should be mapped to the choices: %v, %v", ph.IsValid(), ph.GetOutput())
+	}
+	if ph = g.Map([]int{}, 10, 0); ph.IsValid() {
+		t.Errorf("the phenotype of an empty genome should be invalid: %v", ph.GetOutput())
+	}
+}
+
+func TestGrammaticalEvolution(t *testing.T) {
+	g := ParseBNF(`<sum> ::= <num> | <num> + <sum>
+<num> ::= 1 | 2 | 3 | 5 | 8`)
+	target := 57.0
+	evaluate := NewEvaluator(g, 2, 20, func(program string) []float64 {
+		total := 0.0
+		for _, token := range strings.Split(program, " + ") {
+			v, err := strconv.Atoi(token)
+			if err != nil {
+				t.Fatalf("wrong program: %s", program)
+			}
+			total += float64(v)
+		}
+		return []float64{math.Abs(total - target)}
+	}, []float64{math.Inf(1)})
+	size, length, ngen, cxpb, mutpb := 50, 30, 50, 0.7, 0.2
+	codon := func() int { return rand.Intn(256) }
+	pop := make(base.Individuals, size)
+	for i := range pop {
+		ind := base.NewIntIndividual(inits.GenerateIntSliceRepeat(codon, length), base.NewFitness([]float64{-1.0}))
+		ind.GetFitness().SetValues(evaluate(ind))
+		pop[i] = ind
+	}
+	first := selection.SelBest(pop, 1)[0].GetFitness().GetValues()[0]
+	for gen := 0; gen < ngen; gen++ {
+		offspring := make(base.Individuals, size)
+		for i, ind := range selection.SelTournament(pop, size, 3) {
+			offspring[i] = ind.Clone().(*base.IntIndividual)
+		}
+		for i := 1; i < size; i += 2 {
+			if rand.Float64() < cxpb {
+				crossover.CxOnePointInt(offspring[i-1].(*base.IntIndividual), offspring[i].(*base.IntIndividual))
+			}
+		}
+		for _, ind := range offspring {
+			if rand.Float64() < mutpb {
+				mutation.MutUniformInt(ind.(*base.IntIndividual), 0, 256, 0.1)
+			}
+			ind.GetFitness().SetValues(evaluate(ind.(*base.IntIndividual)))
+		}
+		offspring[0] = selection.SelBest(pop, 1)[0].Clone().(*base.IntIndividual)
+		pop = offspring
+	}
+	best := selection.SelBest(pop, 1)[0].(*base.IntIndividual)
+	last := best.GetFitness().GetValues()[0]
+	if last > first || last > 5.0 {
+		t.Errorf("the error of the best program should be decreased to at most 5: %v -> %v", first, last)
+	}
+	t.Logf("best error: %v -> %v, %s", first, last, g.MapIndividual(best, 2, 20).GetOutput())
+}
@@ -0,0 +1,151 @@
+package gp
+
+import (
+	"math/rand"
+)
+
+// Mutation is the type of the mutation operators of Tree, the tree is modified in place
+type Mutation func(ind *Tree) *Tree
+
+// MutUniform randomly selects a node in the tree and replaces the subtree at that node by an expression generated by expr,
+// expr returns an expression whose root returns type ret, such as func(ret Type) []Node { return Generate(pset, 0, 2, FullCondition, ret) }.
+func MutUniform(ind *Tree, expr func(ret Type) []Node) *Tree {
+	index := rand.Intn(ind.Len())
+	end := searchSubtree(ind.nodes, index)
+	ind.nodes = replaceNodes(ind.nodes, index, end, expr(ind.nodes[index].GetRet()))
+	return ind
+}
+
+// MutNodeReplacement replaces a randomly chosen node by a random node of pset with the same type and the same argument types,
+// the tree isn't changed if there is no such node.
+func MutNodeReplacement(ind *Tree, pset *PrimitiveSet) *Tree {
+	index := rand.Intn(ind.Len())
+	node := ind.nodes[index]
+	if prim, ok := node.(*Primitive); ok {
+		candidates := make([]*Primitive, 0)
+		for _, p := range pset.GetPrimitives(prim.ret) {
+			if sameTypes(p.args, prim.args) {
+				candidates = append(candidates, p)
+			}
+		}
+		if len(candidates) > 0 {
+			ind.nodes[index] = candidates[rand.Intn(len(candidates))]
+		}
+	} else if terms := pset.GetTerminals(node.GetRet()); len(terms) > 0 {
+		ind.nodes[index] = instantiate(terms[rand.Intn(len(terms))])
+	}
+	return ind
+}
+
+// MutEphemeral regenerates the values of the ephemeral constants in the tree, all of them are regenerated if all is true, otherwise only one of them is regenerated
+func MutEphemeral(ind *Tree, all bool) *Tree {
+	indexes := make([]int, 0)
+	for i, node := range ind.nodes {
+		if term, ok := node.(*Terminal); ok && term.ephemeral != nil {
+			indexes = append(indexes, i)
+		}
+	}
+	if len(indexes) == 0 {
+		return ind
+	}
+	if !all {
+		indexes = []int{indexes[rand.Intn(len(indexes))]}
+	}
+	for _, i := range indexes {
+		ind.nodes[i] = ind.nodes[i].(*Terminal).ephemeral.Generate()
+	}
+	return ind
+}
+
+// MutInsert inserts a new branch at a random position in the tree, the subtree at the position is used as one of the children of the new primitive,
+// and the other children are random terminals. The tree isn't changed if there is no primitive taking an argument of the type of the subtree.
+func MutInsert(ind *Tree, pset *PrimitiveSet) *Tree {
+	index := rand.Intn(ind.Len())
+	node := ind.nodes[index]
+	end := searchSubtree(ind.nodes, index)
+	candidates := make([]*Primitive, 0)
+	for _, p := range pset.GetPrimitives(node.GetRet()) {
+		for _, t := range p.args {
+			if t == node.GetRet() {
+				candidates = append(candidates, p)
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return ind
+	}
+	prim := candidates[rand.Intn(len(candidates))]
+	positions := make([]int, 0, prim.GetArity())
+	for i, t := range prim.args {
+		if t == node.GetRet() {
+			positions = append(positions, i)
+		}
+	}
+	position := positions[rand.Intn(len(positions))]
+	branch := []Node{prim}
+	for i, t := range prim.args {
+		if i == position {
+			branch = append(branch, ind.nodes[index:end]...)
+			continue
+		}
+		terms := pset.GetTerminals(t)
+		if len(terms) == 0 {
+			return ind
+		}
+		branch = append(branch, instantiate(terms[rand.Intn(len(terms))]))
+	}
+	ind.nodes = replaceNodes(ind.nodes, index, end, branch)
+	return ind
+}
+
+// MutShrink randomly chooses a primitive (except the root) and replaces the subtree at it by one of its children of the same type,
+// the tree isn't changed if it is too small or there is no such primitive.
+func MutShrink(ind *Tree) *Tree {
+	if ind.Len() < 3 || ind.Height() <= 1 {
+		return ind
+	}
+	indexes := make([]int, 0)
+	for i, node := range ind.nodes[1:] {
+		if prim, ok := node.(*Primitive); ok {
+			for _, t := range prim.args {
+				if t == prim.ret {
+					indexes = append(indexes, i+1)
+					break
+				}
+			}
+		}
+	}
+	if len(indexes) == 0 {
+		return ind
+	}
+	index := indexes[rand.Intn(len(indexes))]
+	prim := ind.nodes[index].(*Primitive)
+	args := make([]int, 0, prim.GetArity())
+	for i, t := range prim.args {
+		if t == prim.ret {
+			args = append(args, i)
+		}
+	}
+	argIndex := args[rand.Intn(len(args))]
+	begin := index + 1
+	for i := 0; i < argIndex; i++ {
+		begin = searchSubtree(ind.nodes, begin)
+	}
+	child := ind.nodes[begin:searchSubtree(ind.nodes, begin)]
+	ind.nodes = replaceNodes(ind.nodes, index, searchSubtree(ind.nodes, index), child)
+	return ind
+}
+
+// sameTypes returns if the two slices of types are the same
+func sameTypes(a, b []Type) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, t := range a {
+		if b[i] != t {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+package gp
+
+import (
+	"testing"
+
+	"github.com/sineatos/deag/base"
+)
+
+func TestMutation(t *testing.T) {
+	psets := []*PrimitiveSet{newArithmeticPrimitiveSet(), newTypedPrimitiveSet()}
+	for _, pset := range psets {
+		expr := func(ret Type) []Node {
+			return Generate(pset, 0, 2, GrowCondition(pset, 0), ret)
+		}
+		mutations := map[string]Mutation{
+			"uniform":          func(ind *Tree) *Tree { return MutUniform(ind, expr) },
+			"node replacement": func(ind *Tree) *Tree { return MutNodeReplacement(ind, pset) },
+			"ephemeral":        func(ind *Tree) *Tree { return MutEphemeral(ind, false) },
+			"insert":           func(ind *Tree) *Tree { return MutInsert(ind, pset) },
+			"shrink":           MutShrink,
+		}
+		for name, mutate := range mutations {
+			for n := 0; n < 100; n++ {
+				ind := NewTree(GenHalfAndHalf(pset, 1, 4), nil)
+				height, size := ind.Height(), ind.Len()
+				ind = mutate(ind)
+				checkTypes(t, ind.nodes, pset.GetRet())
+				switch name {
+				case "node replacement", "ephemeral":
+					if ind.Len() != size {
+						t.Errorf("%s: the size of tree is changed: %v", name, ind)
+					}
+				case "insert":
+					if ind.Height() < height {
+						t.Errorf("%s: the tree becomes lower: %v", name, ind)
+					}
+				case "shrink":
+					if ind.Len() > size {
+						t.Errorf("%s: the tree becomes larger: %v", name, ind)
+					}
+				}
+			}
+		}
+	}
+	// all the ephemeral constants are regenerated
+	pset := newArithmeticPrimitiveSet()
+	ind := NewTreeFromString("add(rand101, mul(x, rand101))", pset, base.NewFitness([]float64{-1.0}))
+	terms := []Node{ind.nodes[1], ind.nodes[4]}
+	MutEphemeral(ind, true)
+	if ind.nodes[1] == terms[0] || ind.nodes[4] == terms[1] {
+		t.Errorf("the ephemeral constants aren't regenerated: %v", ind)
+	}
+}
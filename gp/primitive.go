@@ -0,0 +1,233 @@
+package gp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Type is the type of the values returned by the nodes and taken by the primitives, it is used by strongly typed GP to restrict the trees
+type Type string
+
+// AnyType is the only type used by the loosely typed PrimitiveSet
+const AnyType Type = "any"
+
+// Function is the function of a Primitive, the length of args is the arity of the Primitive
+type Function func(args ...interface{}) interface{}
+
+// Node is an element of the prefix expression of a Tree
+type Node interface {
+	// GetName returns the name of the node
+	GetName() string
+	// GetArity returns the number of arguments of the node, it is 0 for the terminals
+	GetArity() int
+	// GetRet returns the type of the value returned by the node
+	GetRet() Type
+	// Format returns the prefix representation of the node whose arguments are represented by args
+	Format(args ...string) string
+
+	String() string
+}
+
+// Primitive is the node of a function, whose children are the arguments of the function
+type Primitive struct {
+	name   string
+	fn     Function
+	args   []Type
+	ret    Type
+	symbol string
+	adf    bool
+}
+
+// NewPrimitive returns *Primitive, args are the types of the arguments and ret is the type of the returned value
+func NewPrimitive(name string, fn Function, args []Type, ret Type) *Primitive {
+	return &Primitive{name: name, fn: fn, args: args, ret: ret}
+}
+
+// GetName returns the name of the primitive
+func (prim *Primitive) GetName() string {
+	return prim.name
+}
+
+// GetArity returns the number of arguments
+func (prim *Primitive) GetArity() int {
+	return len(prim.args)
+}
+
+// GetArgs returns the types of the arguments (not a copy)
+func (prim *Primitive) GetArgs() []Type {
+	return prim.args
+}
+
+// GetRet returns the type of the returned value
+func (prim *Primitive) GetRet() Type {
+	return prim.ret
+}
+
+// GetFunction returns the function of the primitive, it is nil for the ADF primitives which are bound by CompileADF
+func (prim *Primitive) GetFunction() Function {
+	return prim.fn
+}
+
+// IsADF returns if the primitive calls an automatically defined function, see PrimitiveSet.AddADF
+func (prim *Primitive) IsADF() bool {
+	return prim.adf
+}
+
+// GetSymbol returns the infix symbol of the primitive, it is empty if the primitive has no symbol
+func (prim *Primitive) GetSymbol() string {
+	return prim.symbol
+}
+
+// Format returns name(arg1, arg2, ...)
+func (prim *Primitive) Format(args ...string) string {
+	return fmt.Sprintf("%s(%s)", prim.name, strings.Join(args, ", "))
+}
+
+// FormatInfix returns (arg1 symbol arg2) for the binary primitives and (symbol arg) for the unary primitives which have symbols, otherwise returns the same as Format
+func (prim *Primitive) FormatInfix(args ...string) string {
+	if prim.symbol != "" {
+		switch len(args) {
+		case 1:
+			return fmt.Sprintf("(%s%s)", prim.symbol, args[0])
+		case 2:
+			return fmt.Sprintf("(%s %s %s)", args[0], prim.symbol, args[1])
+		}
+	}
+	return prim.Format(args...)
+}
+
+func (prim *Primitive) String() string {
+	return prim.name
+}
+
+// Terminal is the node of a constant value
+type Terminal struct {
+	name      string
+	value     interface{}
+	ret       Type
+	ephemeral *Ephemeral
+}
+
+// NewTerminal returns *Terminal
+func NewTerminal(name string, value interface{}, ret Type) *Terminal {
+	return &Terminal{name: name, value: value, ret: ret}
+}
+
+// GetName returns the name of the terminal, the name of a terminal generated by Ephemeral is the name of the Ephemeral
+func (term *Terminal) GetName() string {
+	return term.name
+}
+
+// GetArity returns 0
+func (term *Terminal) GetArity() int {
+	return 0
+}
+
+// GetRet returns the type of the value
+func (term *Terminal) GetRet() Type {
+	return term.ret
+}
+
+// GetValue returns the value of the terminal
+func (term *Terminal) GetValue() interface{} {
+	return term.value
+}
+
+// GetEphemeral returns the Ephemeral generating the terminal, it returns nil if the terminal isn't generated by an Ephemeral
+func (term *Terminal) GetEphemeral() *Ephemeral {
+	return term.ephemeral
+}
+
+// Format returns the name of the terminal, or the value if the terminal is generated by an Ephemeral
+func (term *Terminal) Format(args ...string) string {
+	return term.String()
+}
+
+func (term *Terminal) String() string {
+	if term.ephemeral != nil {
+		return fmt.Sprint(term.value)
+	}
+	return term.name
+}
+
+// Ephemeral is the generator of the ephemeral constants, a new Terminal whose value is generated by the Ephemeral is created whenever the Ephemeral is chosen
+type Ephemeral struct {
+	name     string
+	generate func() interface{}
+	ret      Type
+}
+
+// NewEphemeral returns *Ephemeral, generate returns the values of the constants
+func NewEphemeral(name string, generate func() interface{}, ret Type) *Ephemeral {
+	return &Ephemeral{name: name, generate: generate, ret: ret}
+}
+
+// GetName returns the name of the ephemeral
+func (eph *Ephemeral) GetName() string {
+	return eph.name
+}
+
+// GetArity returns 0
+func (eph *Ephemeral) GetArity() int {
+	return 0
+}
+
+// GetRet returns the type of the generated values
+func (eph *Ephemeral) GetRet() Type {
+	return eph.ret
+}
+
+// Generate returns a new Terminal with a generated value
+func (eph *Ephemeral) Generate() *Terminal {
+	return &Terminal{name: eph.name, value: eph.generate(), ret: eph.ret, ephemeral: eph}
+}
+
+// Format returns the name of the ephemeral
+func (eph *Ephemeral) Format(args ...string) string {
+	return eph.name
+}
+
+func (eph *Ephemeral) String() string {
+	return eph.name
+}
+
+// Argument is the node of an argument of the compiled function
+type Argument struct {
+	name  string
+	index int
+	ret   Type
+}
+
+// NewArgument returns *Argument, index is the index of the argument in the arguments of the compiled function
+func NewArgument(name string, index int, ret Type) *Argument {
+	return &Argument{name: name, index: index, ret: ret}
+}
+
+// GetName returns the name of the argument
+func (arg *Argument) GetName() string {
+	return arg.name
+}
+
+// GetArity returns 0
+func (arg *Argument) GetArity() int {
+	return 0
+}
+
+// GetRet returns the type of the argument
+func (arg *Argument) GetRet() Type {
+	return arg.ret
+}
+
+// GetIndex returns the index of the argument in the arguments of the compiled function
+func (arg *Argument) GetIndex() int {
+	return arg.index
+}
+
+// Format returns the name of the argument
+func (arg *Argument) Format(args ...string) string {
+	return arg.name
+}
+
+func (arg *Argument) String() string {
+	return arg.name
+}
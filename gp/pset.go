@@ -0,0 +1,170 @@
+package gp
+
+import (
+	"fmt"
+)
+
+// PrimitiveSet contains the primitives and terminals which can be used to build the trees.
+// In a strongly typed PrimitiveSet, the type of the node at each argument of a primitive should be the type of the argument, and the root of a tree should return the type of the PrimitiveSet.
+// A loosely typed PrimitiveSet uses AnyType for all the nodes.
+type PrimitiveSet struct {
+	name       string
+	ret        Type
+	primitives map[Type][]*Primitive
+	terminals  map[Type][]Node
+	arguments  []*Argument
+	mapping    map[string]Node
+	prims      int
+	terms      int
+}
+
+// NewTypedPrimitiveSet returns a strongly typed *PrimitiveSet.
+// in are the types of the arguments of the compiled function, which are named ARG0, ARG1, ... by default.
+// ret is the type of the value returned by the compiled function.
+func NewTypedPrimitiveSet(name string, in []Type, ret Type) *PrimitiveSet {
+	pset := &PrimitiveSet{
+		name:       name,
+		ret:        ret,
+		primitives: make(map[Type][]*Primitive),
+		terminals:  make(map[Type][]Node),
+		mapping:    make(map[string]Node),
+	}
+	for i, t := range in {
+		arg := NewArgument(fmt.Sprintf("ARG%d", i), i, t)
+		pset.arguments = append(pset.arguments, arg)
+		pset.addTerminal(arg)
+	}
+	return pset
+}
+
+// NewPrimitiveSet returns a loosely typed *PrimitiveSet, arity is the number of arguments of the compiled function
+func NewPrimitiveSet(name string, arity int) *PrimitiveSet {
+	in := make([]Type, arity)
+	for i := range in {
+		in[i] = AnyType
+	}
+	return NewTypedPrimitiveSet(name, in, AnyType)
+}
+
+// GetName returns the name of the PrimitiveSet
+func (pset *PrimitiveSet) GetName() string {
+	return pset.name
+}
+
+// GetRet returns the type of the value returned by the compiled function
+func (pset *PrimitiveSet) GetRet() Type {
+	return pset.ret
+}
+
+// GetArguments returns the arguments of the compiled function (not a copy)
+func (pset *PrimitiveSet) GetArguments() []*Argument {
+	return pset.arguments
+}
+
+// GetPrimitives returns the primitives returning type t (not a copy)
+func (pset *PrimitiveSet) GetPrimitives(t Type) []*Primitive {
+	return pset.primitives[t]
+}
+
+// GetTerminals returns the terminals, ephemerals and arguments of type t (not a copy)
+func (pset *PrimitiveSet) GetTerminals(t Type) []Node {
+	return pset.terminals[t]
+}
+
+// Lookup returns the node named name, it returns nil if there is no such node
+func (pset *PrimitiveSet) Lookup(name string) Node {
+	return pset.mapping[name]
+}
+
+// TerminalRatio returns the ratio of the number of terminals to the number of all the nodes
+func (pset *PrimitiveSet) TerminalRatio() float64 {
+	return float64(pset.terms) / float64(pset.terms+pset.prims)
+}
+
+// AddPrimitive adds a primitive, args are the types of the arguments and ret is the type of the returned value
+func (pset *PrimitiveSet) AddPrimitive(name string, fn Function, args []Type, ret Type) {
+	pset.checkName(name)
+	prim := NewPrimitive(name, fn, args, ret)
+	pset.primitives[ret] = append(pset.primitives[ret], prim)
+	pset.mapping[name] = prim
+	pset.prims++
+}
+
+// AddADF adds a primitive calling the automatically defined function built with adfset, the primitive is named by the name of adfset,
+// its arguments are the arguments of adfset and it returns the type of adfset. The primitive is bound by CompileADF.
+func (pset *PrimitiveSet) AddADF(adfset *PrimitiveSet) {
+	args := make([]Type, len(adfset.arguments))
+	for i, arg := range adfset.arguments {
+		args[i] = arg.ret
+	}
+	pset.AddPrimitive(adfset.name, nil, args, adfset.ret)
+	pset.mapping[adfset.name].(*Primitive).adf = true
+}
+
+// AddTerminal adds a terminal of type ret whose value is value
+func (pset *PrimitiveSet) AddTerminal(name string, value interface{}, ret Type) {
+	pset.addTerminal(NewTerminal(name, value, ret))
+}
+
+// AddEphemeral adds an ephemeral constant of type ret, generate returns the values of the constants
+func (pset *PrimitiveSet) AddEphemeral(name string, generate func() interface{}, ret Type) {
+	pset.addTerminal(NewEphemeral(name, generate, ret))
+}
+
+// AddUntypedPrimitive adds a primitive with arity arguments, all the types are AnyType
+func (pset *PrimitiveSet) AddUntypedPrimitive(name string, fn Function, arity int) {
+	args := make([]Type, arity)
+	for i := range args {
+		args[i] = AnyType
+	}
+	pset.AddPrimitive(name, fn, args, AnyType)
+}
+
+// AddUntypedTerminal adds a terminal of AnyType
+func (pset *PrimitiveSet) AddUntypedTerminal(name string, value interface{}) {
+	pset.AddTerminal(name, value, AnyType)
+}
+
+// AddUntypedEphemeral adds an ephemeral constant of AnyType
+func (pset *PrimitiveSet) AddUntypedEphemeral(name string, generate func() interface{}) {
+	pset.AddEphemeral(name, generate, AnyType)
+}
+
+// SetSymbol sets the infix symbol of the primitive named name, such as "+" for add, which is used by Tree.Infix
+func (pset *PrimitiveSet) SetSymbol(name, symbol string) {
+	prim, ok := pset.mapping[name].(*Primitive)
+	if !ok {
+		panic(fmt.Sprintf("There is no primitive named %s in %s", name, pset.name))
+	}
+	prim.symbol = symbol
+}
+
+// RenameArguments renames the arguments in order, such as RenameArguments("x", "y") renames ARG0 to x and ARG1 to y
+func (pset *PrimitiveSet) RenameArguments(names ...string) {
+	if len(names) > len(pset.arguments) {
+		panic(fmt.Sprintf("There are only %d arguments in %s: %v", len(pset.arguments), pset.name, names))
+	}
+	for i, name := range names {
+		arg := pset.arguments[i]
+		if name == arg.name {
+			continue
+		}
+		pset.checkName(name)
+		delete(pset.mapping, arg.name)
+		arg.name = name
+		pset.mapping[name] = arg
+	}
+}
+
+func (pset *PrimitiveSet) addTerminal(term Node) {
+	pset.checkName(term.GetName())
+	pset.terminals[term.GetRet()] = append(pset.terminals[term.GetRet()], term)
+	pset.mapping[term.GetName()] = term
+	pset.terms++
+}
+
+func (pset *PrimitiveSet) checkName(name string) {
+	if _, ok := pset.mapping[name]; ok {
+		panic(fmt.Sprintf("Node names must be unique in a PrimitiveSet: %s is already in %s", name, pset.name))
+	}
+}
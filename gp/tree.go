@@ -0,0 +1,207 @@
+package gp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sineatos/deag/base"
+)
+
+// Tree is the individual of GP, which saves the tree in a prefix expression (depth-first order).
+// The chromosome of Tree is []Node, the nodes are shared by the clones because they are never changed.
+type Tree struct {
+	// Chromosome
+	nodes []Node
+
+	// Fitness
+	fitness *base.Fitness
+}
+
+// NewTree returns a Tree
+func NewTree(nodes []Node, fitness *base.Fitness) *Tree {
+	return &Tree{nodes: nodes, fitness: fitness}
+}
+
+// NewTreeFromString returns a Tree parsed from the prefix expression expr, such as "add(ARG0, mul(ARG0, 3))".
+// The names in expr are looked up in pset, the other tokens are parsed as int or float64 Terminals.
+func NewTreeFromString(expr string, pset *PrimitiveSet, fitness *base.Fitness) *Tree {
+	tokens := strings.FieldsFunc(expr, func(r rune) bool {
+		return r == '(' || r == ')' || r == ',' || r == ' ' || r == '\t' || r == '\n'
+	})
+	nodes := make([]Node, 0, len(tokens))
+	types := []Type{pset.ret}
+	for _, token := range tokens {
+		if len(types) == 0 {
+			panic(fmt.Sprintf("Too many nodes in the expression: %s", expr))
+		}
+		ret := types[len(types)-1]
+		types = types[:len(types)-1]
+		node := pset.Lookup(token)
+		switch n := node.(type) {
+		case *Primitive:
+			for i := n.GetArity() - 1; i >= 0; i-- {
+				types = append(types, n.args[i])
+			}
+		case *Ephemeral:
+			node = n.Generate()
+		case nil:
+			if value, err := strconv.Atoi(token); err == nil {
+				node = NewTerminal(token, value, ret)
+			} else if value, err := strconv.ParseFloat(token, 64); err == nil {
+				node = NewTerminal(token, value, ret)
+			} else {
+				panic(fmt.Sprintf("Unknown token %s in the expression: %s", token, expr))
+			}
+		}
+		if node.GetRet() != ret {
+			panic(fmt.Sprintf("The type of %s should be %s, not %s: %s", token, ret, node.GetRet(), expr))
+		}
+		nodes = append(nodes, node)
+	}
+	if len(types) != 0 {
+		panic(fmt.Sprintf("Too few nodes in the expression: %s", expr))
+	}
+	return NewTree(nodes, fitness)
+}
+
+// Len returns the number of nodes
+func (tree *Tree) Len() int {
+	return len(tree.nodes)
+}
+
+// Clone returns an copy of Tree
+func (tree *Tree) Clone() interface{} {
+	nodes := make([]Node, len(tree.nodes))
+	copy(nodes, tree.nodes)
+	return &Tree{nodes: nodes, fitness: tree.fitness.Clone()}
+}
+
+// GetChromosome gets the node slice (not a copy)
+func (tree *Tree) GetChromosome() interface{} {
+	return tree.nodes
+}
+
+// SetChromosome sets the node slice
+func (tree *Tree) SetChromosome(chromosome interface{}) {
+	nodes, ok := chromosome.([]Node)
+	if !ok {
+		panic(fmt.Sprintf("Chromosome should be a []gp.Node: %v", chromosome))
+	}
+	tree.nodes = make([]Node, len(nodes))
+	copy(tree.nodes, nodes)
+}
+
+// GetFitness returns the individual's fitness (not a copy)
+func (tree *Tree) GetFitness() *base.Fitness {
+	return tree.fitness
+}
+
+// IsEqual returns if the other individual is a Tree with the same nodes
+func (tree *Tree) IsEqual(other base.Individual) bool {
+	if otherTree, ok := other.(*Tree); ok && len(otherTree.nodes) == len(tree.nodes) {
+		for i, node := range tree.nodes {
+			otherNode := otherTree.nodes[i]
+			if node != otherNode && (node.GetName() != otherNode.GetName() || node.String() != otherNode.String()) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Height returns the height of the tree, the height of a tree having only a terminal is 0
+func (tree *Tree) Height() int {
+	stack := []int{0}
+	maxDepth := 0
+	for _, node := range tree.nodes {
+		depth := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+		for i := 0; i < node.GetArity(); i++ {
+			stack = append(stack, depth+1)
+		}
+	}
+	return maxDepth
+}
+
+// IsValid returns if the tree is a valid expression of pset, see IsValidExpr
+func (tree *Tree) IsValid(pset *PrimitiveSet) bool {
+	return IsValidExpr(tree.nodes, pset.ret)
+}
+
+// SearchSubtree returns the end (exclusive) of the subtree whose root is at begin, the subtree is nodes[begin:end]
+func (tree *Tree) SearchSubtree(begin int) int {
+	return searchSubtree(tree.nodes, begin)
+}
+
+// Infix returns the infix representation of the tree, the primitives having symbols are represented as (a symbol b)
+func (tree *Tree) Infix() string {
+	return format(tree.nodes, true)
+}
+
+// String returns the prefix representation of the tree, such as add(ARG0, mul(ARG0, 3))
+func (tree *Tree) String() string {
+	return format(tree.nodes, false)
+}
+
+// IsValidExpr returns if nodes is a complete prefix expression whose root returns ret,
+// and the type returned by each node is the type of the argument of its parent, which is the constraint of strongly typed GP.
+func IsValidExpr(nodes []Node, ret Type) bool {
+	types := []Type{ret}
+	for _, node := range nodes {
+		if len(types) == 0 {
+			return false
+		}
+		t := types[len(types)-1]
+		types = types[:len(types)-1]
+		if node.GetRet() != t {
+			return false
+		}
+		if prim, ok := node.(*Primitive); ok {
+			for i := prim.GetArity() - 1; i >= 0; i-- {
+				types = append(types, prim.args[i])
+			}
+		}
+	}
+	return len(types) == 0
+}
+
+// searchSubtree returns the end (exclusive) of the subtree in nodes whose root is at begin
+func searchSubtree(nodes []Node, begin int) int {
+	end, total := begin+1, nodes[begin].GetArity()
+	for total > 0 {
+		total += nodes[end].GetArity() - 1
+		end++
+	}
+	return end
+}
+
+// format returns the string of the prefix expression nodes
+func format(nodes []Node, infix bool) string {
+	type frame struct {
+		node Node
+		args []string
+	}
+	str := ""
+	stack := make([]*frame, 0)
+	for _, node := range nodes {
+		stack = append(stack, &frame{node: node})
+		for len(stack) > 0 && len(stack[len(stack)-1].args) == stack[len(stack)-1].node.GetArity() {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if prim, ok := top.node.(*Primitive); ok && infix {
+				str = prim.FormatInfix(top.args...)
+			} else {
+				str = top.node.Format(top.args...)
+			}
+			if len(stack) > 0 {
+				stack[len(stack)-1].args = append(stack[len(stack)-1].args, str)
+			}
+		}
+	}
+	return str
+}
@@ -0,0 +1,105 @@
+package gp
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+)
+
+const (
+	floatType Type = "float64"
+	boolType  Type = "bool"
+)
+
+func newArithmeticPrimitiveSet() *PrimitiveSet {
+	pset := NewPrimitiveSet("MAIN", 1)
+	pset.AddUntypedPrimitive("add", func(args ...interface{}) interface{} { return args[0].(float64) + args[1].(float64) }, 2)
+	pset.AddUntypedPrimitive("sub", func(args ...interface{}) interface{} { return args[0].(float64) - args[1].(float64) }, 2)
+	pset.AddUntypedPrimitive("mul", func(args ...interface{}) interface{} { return args[0].(float64) * args[1].(float64) }, 2)
+	pset.AddUntypedPrimitive("neg", func(args ...interface{}) interface{} { return -args[0].(float64) }, 1)
+	pset.AddUntypedEphemeral("rand101", func() interface{} { return float64(rand.Intn(3) - 1) })
+	pset.AddUntypedTerminal("one", 1.0)
+	pset.SetSymbol("add", "+")
+	pset.SetSymbol("sub", "-")
+	pset.SetSymbol("mul", "*")
+	pset.SetSymbol("neg", "-")
+	pset.RenameArguments("x")
+	return pset
+}
+
+func newTypedPrimitiveSet() *PrimitiveSet {
+	pset := NewTypedPrimitiveSet("TYPED", []Type{floatType, floatType}, floatType)
+	pset.AddPrimitive("add", func(args ...interface{}) interface{} { return args[0].(float64) + args[1].(float64) }, []Type{floatType, floatType}, floatType)
+	pset.AddPrimitive("lt", func(args ...interface{}) interface{} { return args[0].(float64) < args[1].(float64) }, []Type{floatType, floatType}, boolType)
+	pset.AddPrimitive("if_then_else", func(args ...interface{}) interface{} {
+		if args[0].(bool) {
+			return args[1]
+		}
+		return args[2]
+	}, []Type{boolType, floatType, floatType}, floatType)
+	pset.AddTerminal("true", true, boolType)
+	pset.AddTerminal("false", false, boolType)
+	pset.AddEphemeral("rand100", func() interface{} { return float64(rand.Intn(101)) }, floatType)
+	return pset
+}
+
+func TestTree(t *testing.T) {
+	pset := newArithmeticPrimitiveSet()
+	fit := base.NewFitness([]float64{-1.0})
+	tree := NewTreeFromString("add(x, mul(neg(x), 3))", pset, fit)
+	if tree.Len() != 6 {
+		t.Errorf("the length of tree should be 6: %v", tree.Len())
+	}
+	if tree.Height() != 3 {
+		t.Errorf("the height of tree should be 3: %v", tree.Height())
+	}
+	if str := tree.String(); str != "add(x, mul(neg(x), 3))" {
+		t.Errorf("wrong prefix representation: %s", str)
+	}
+	if str := tree.Infix(); str != "(x + ((-x) * 3))" {
+		t.Errorf("wrong infix representation: %s", str)
+	}
+	if end := tree.SearchSubtree(2); end != 6 {
+		t.Errorf("the subtree mul(neg(x), 3) should end at 6: %v", end)
+	}
+	cTree := tree.Clone().(*Tree)
+	if !cTree.IsEqual(tree) || cTree.GetFitness() == tree.GetFitness() {
+		t.Errorf("wrong clone: %v", cTree)
+	}
+	cTree.SetChromosome(cTree.GetChromosome().([]Node)[2:])
+	if cTree.IsEqual(tree) || cTree.String() != "mul(neg(x), 3)" {
+		t.Errorf("wrong chromosome: %v", cTree)
+	}
+}
+
+func TestTypedTree(t *testing.T) {
+	pset := newTypedPrimitiveSet()
+	tree := NewTreeFromString("if_then_else(lt(ARG0, ARG1), ARG1, add(ARG0, 1.5))", pset, base.NewFitness([]float64{1.0}))
+	if tree.Len() != 8 {
+		t.Errorf("the length of tree should be 8: %v", tree.Len())
+	}
+	if !tree.IsValid(pset) {
+		t.Errorf("the tree should be valid: %v", tree)
+	}
+	add, arg := pset.Lookup("add"), pset.Lookup("ARG0")
+	invalids := [][]Node{
+		{add, pset.Lookup("true"), arg},
+		{add, arg},
+		{add, arg, arg, arg},
+		{pset.Lookup("lt"), arg, arg},
+	}
+	for _, expr := range invalids {
+		if IsValidExpr(expr, pset.GetRet()) {
+			t.Errorf("the expression should be invalid: %v", expr)
+		}
+	}
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("NewTreeFromString doesn't panic with a wrong type")
+			}
+		}()
+		NewTreeFromString("if_then_else(ARG0, ARG1, ARG0)", pset, base.NewFitness([]float64{1.0}))
+	}()
+}
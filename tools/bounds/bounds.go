@@ -0,0 +1,201 @@
+package bounds
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/utility"
+)
+
+// Handler repairs the component x which is out of [low, up] and returns the repaired component.
+// parent is the component of the parent (the solution before variation) in the same dimension.
+type Handler func(x, low, up, parent float64) float64
+
+/***************************
+ * Handlers                *
+ ***************************/
+
+// Clip sets the component to the violated bound
+func Clip(x, low, up, parent float64) float64 {
+	return math.Min(math.Max(x, low), up)
+}
+
+// Reflect mirrors the component back into the range at the violated bound, repeatedly if it is far away from the range
+func Reflect(x, low, up, parent float64) float64 {
+	width := up - low
+	if width <= 0.0 {
+		return low
+	}
+	y := math.Mod(x-low, 2.0*width)
+	if y < 0.0 {
+		y += 2.0 * width
+	}
+	if y > width {
+		y = 2.0*width - y
+	}
+	return low + y
+}
+
+// Wrap treats the range as toroidal, the component leaving the range at one bound comes back from the other bound
+func Wrap(x, low, up, parent float64) float64 {
+	width := up - low
+	if width <= 0.0 {
+		return low
+	}
+	y := math.Mod(x-low, width)
+	if y < 0.0 {
+		y += width
+	}
+	return low + y
+}
+
+// RandomReinit reinitializes the component uniformly in the range
+func RandomReinit(x, low, up, parent float64) float64 {
+	return low + rand.Float64()*(up-low)
+}
+
+// MidpointToParent sets the component to the midpoint between the parent and the violated bound
+func MidpointToParent(x, low, up, parent float64) float64 {
+	parent = Clip(parent, low, up, parent)
+	if x < low {
+		return (low + parent) / 2.0
+	}
+	return (up + parent) / 2.0
+}
+
+/***************************
+ * Bounds                  *
+ ***************************/
+
+// Bounds keeps the lower bound, the upper bound and the Handler of each dimension
+type Bounds struct {
+	low      []float64
+	up       []float64
+	handlers []Handler
+}
+
+// NewBounds returns *Bounds of size dimensions.
+//
+// low(float64 or []float64): The lower bound of the search space.
+//
+// up(float64 or []float64): The upper bound of the search space.
+//
+// handler(Handler or []Handler): The Handler used to repair each dimension.
+func NewBounds(low, up interface{}, handler interface{}, size int) *Bounds {
+	lows := utility.Interface2Float64Slice("low", low, size)
+	ups := utility.Interface2Float64Slice("up", up, size)
+	var handlers []Handler
+	switch h := handler.(type) {
+	case Handler:
+		handlers = make([]Handler, size)
+		for i := range handlers {
+			handlers[i] = h
+		}
+	case func(x, low, up, parent float64) float64:
+		handlers = make([]Handler, size)
+		for i := range handlers {
+			handlers[i] = h
+		}
+	case []Handler:
+		handlers = h
+		if len(handlers) < size {
+			panic(fmt.Sprintf("handler must be at least the size of individual: %d < %d", len(handlers), size))
+		}
+	default:
+		panic("handler must be Handler or []Handler")
+	}
+	return &Bounds{low: lows[:size], up: ups[:size], handlers: handlers[:size]}
+}
+
+// Len returns the number of dimensions
+func (b *Bounds) Len() int {
+	return len(b.low)
+}
+
+// Low returns the lower bound of dimension i
+func (b *Bounds) Low(i int) float64 {
+	return b.low[i]
+}
+
+// Up returns the upper bound of dimension i
+func (b *Bounds) Up(i int) float64 {
+	return b.up[i]
+}
+
+// Contains returns if every component of x is in its range
+func (b *Bounds) Contains(x []float64) bool {
+	for i, v := range x {
+		if v < b.low[i] || v > b.up[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsFeasible returns if individual is in the bounds, it can be used as the isFeasible of constraint.NewClosestValidPenalty
+func (b *Bounds) IsFeasible(individual *base.Float64Individual) bool {
+	return b.Contains(individual.GetChromosome().([]float64))
+}
+
+// Repair repairs the components of x which are out of range in place and returns x.
+// parent is the solution before variation, it can be nil, then the violated bound is used as the parent component.
+func (b *Bounds) Repair(x, parent []float64) []float64 {
+	for i, v := range x {
+		if v < b.low[i] || v > b.up[i] {
+			p := math.Min(math.Max(v, b.low[i]), b.up[i])
+			if parent != nil {
+				p = parent[i]
+			}
+			x[i] = b.handlers[i](v, b.low[i], b.up[i], p)
+		}
+	}
+	return x
+}
+
+// Adjust returns a clone of individual whose chromosome is repaired, individual isn't modified.
+// It can be used as the adjust of constraint.NewClosestValidPenalty, which compares the repaired clone with individual by its distance.
+func (b *Bounds) Adjust(individual *base.Float64Individual) *base.Float64Individual {
+	adjusted := individual.Clone().(*base.Float64Individual)
+	b.Repair(adjusted.GetChromosome().([]float64), nil)
+	return adjusted
+}
+
+// Sample implements the resampling strategy: generate is called until the generated solution is in the bounds at most maxTries times,
+// if no generated solution is feasible, the last one is repaired with parent by the handlers.
+func (b *Bounds) Sample(generate func() []float64, maxTries int, parent []float64) []float64 {
+	x := generate()
+	for tries := 1; tries < maxTries && !b.Contains(x); tries++ {
+		x = generate()
+	}
+	return b.Repair(x, parent)
+}
+
+// Mutation decorates a mutation operator of base.Float64Individual, the mutant is repaired with the individual before mutation as the parent
+func (b *Bounds) Mutation(mutate func(*base.Float64Individual) *base.Float64Individual) func(*base.Float64Individual) *base.Float64Individual {
+	return func(ind *base.Float64Individual) *base.Float64Individual {
+		parent := copyChromosome(ind)
+		mutant := mutate(ind)
+		b.Repair(mutant.GetChromosome().([]float64), parent)
+		return mutant
+	}
+}
+
+// Crossover decorates a crossover operator of base.Float64Individual, each child is repaired with the corresponding individual before crossover as the parent
+func (b *Bounds) Crossover(mate func(*base.Float64Individual, *base.Float64Individual) (*base.Float64Individual, *base.Float64Individual)) func(*base.Float64Individual, *base.Float64Individual) (*base.Float64Individual, *base.Float64Individual) {
+	return func(ind1, ind2 *base.Float64Individual) (*base.Float64Individual, *base.Float64Individual) {
+		parent1, parent2 := copyChromosome(ind1), copyChromosome(ind2)
+		child1, child2 := mate(ind1, ind2)
+		b.Repair(child1.GetChromosome().([]float64), parent1)
+		b.Repair(child2.GetChromosome().([]float64), parent2)
+		return child1, child2
+	}
+}
+
+func copyChromosome(ind *base.Float64Individual) []float64 {
+	chrom := ind.GetChromosome().([]float64)
+	parent := make([]float64, len(chrom))
+	copy(parent, chrom)
+	return parent
+}
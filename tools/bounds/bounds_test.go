@@ -0,0 +1,116 @@
+package bounds
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/crossover"
+	"github.com/sineatos/deag/tools/mutation"
+)
+
+func TestHandlers(t *testing.T) {
+	low, up := -1.0, 1.0
+	cases := []struct {
+		name    string
+		handler Handler
+		x       float64
+		parent  float64
+		target  float64
+	}{
+		{"Clip", Clip, 1.5, 0.0, 1.0},
+		{"Clip", Clip, -3.0, 0.0, -1.0},
+		{"Reflect", Reflect, 1.5, 0.0, 0.5},
+		{"Reflect", Reflect, -1.25, 0.0, -0.75},
+		{"Reflect", Reflect, 3.5, 0.0, -0.5},
+		{"Wrap", Wrap, 1.5, 0.0, -0.5},
+		{"Wrap", Wrap, -1.25, 0.0, 0.75},
+		{"Wrap", Wrap, 5.5, 0.0, -0.5},
+		{"MidpointToParent", MidpointToParent, 1.5, 0.0, 0.5},
+		{"MidpointToParent", MidpointToParent, -2.0, 0.5, -0.25},
+	}
+	for _, c := range cases {
+		if value := c.handler(c.x, low, up, c.parent); math.Abs(value-c.target) > 1E-12 {
+			t.Errorf("%s(%v): expected %v, got %v", c.name, c.x, c.target, value)
+		}
+	}
+	for i := 0; i < 100; i++ {
+		if value := RandomReinit(5.0, low, up, 0.0); value < low || value > up {
+			t.Errorf("RandomReinit returns a value out of range: %v", value)
+		}
+	}
+}
+
+func TestBoundsPerDimension(t *testing.T) {
+	b := NewBounds([]float64{0.0, -1.0, 10.0}, []float64{1.0, 1.0, 20.0}, []Handler{Clip, Reflect, Wrap}, 3)
+	x := []float64{2.0, 1.5, 21.0}
+	b.Repair(x, nil)
+	target := []float64{1.0, 0.5, 11.0}
+	for i := range x {
+		if math.Abs(x[i]-target[i]) > 1E-12 {
+			t.Errorf("Repair: expected %v, got %v", target, x)
+		}
+	}
+	if !b.Contains(x) {
+		t.Errorf("repaired solution isn't in bounds: %v", x)
+	}
+	ind := base.NewFloat64Individual([]float64{-5.0, 0.0, 15.0}, base.NewFitness([]float64{-1.0}))
+	if b.IsFeasible(ind) {
+		t.Errorf("ind isn't feasible: %v", ind)
+	}
+	if adjusted := b.Adjust(ind); !b.IsFeasible(adjusted) || adjusted == ind {
+		t.Errorf("adjusted ind should be a feasible clone: %v", adjusted)
+	}
+	if b.IsFeasible(ind) {
+		t.Errorf("Adjust shouldn't modify ind: %v", ind)
+	}
+}
+
+func TestSample(t *testing.T) {
+	b := NewBounds(0.0, 1.0, Clip, 2)
+	count := 0
+	generate := func() []float64 {
+		count++
+		if count < 3 {
+			return []float64{2.0, 0.5}
+		}
+		return []float64{0.25, 0.5}
+	}
+	x := b.Sample(generate, 10, []float64{0.5, 0.5})
+	if count != 3 || x[0] != 0.25 {
+		t.Errorf("Sample should stop at the first feasible solution: %v after %d tries", x, count)
+	}
+	count = 0
+	x = b.Sample(func() []float64 { count++; return []float64{2.0, 0.5} }, 4, []float64{0.5, 0.5})
+	if count != 4 || !b.Contains(x) {
+		t.Errorf("Sample should repair the last solution after maxTries: %v after %d tries", x, count)
+	}
+}
+
+func TestDecorators(t *testing.T) {
+	size := 5
+	b := NewBounds(0.0, 1.0, Reflect, size)
+	newInd := func() *base.Float64Individual {
+		chrom := make([]float64, size)
+		for i := range chrom {
+			chrom[i] = rand.Float64()
+		}
+		return base.NewFloat64Individual(chrom, base.NewFitness([]float64{-1.0}))
+	}
+	mutate := b.Mutation(func(ind *base.Float64Individual) *base.Float64Individual {
+		return mutation.MutGaussian(ind, 0.0, 5.0, 1.0)
+	})
+	mate := b.Crossover(func(ind1, ind2 *base.Float64Individual) (*base.Float64Individual, *base.Float64Individual) {
+		return crossover.CxBlend(ind1, ind2, 5.0)
+	})
+	for i := 0; i < 100; i++ {
+		if mutant := mutate(newInd()); !b.IsFeasible(mutant) {
+			t.Errorf("mutant isn't in bounds: %v", mutant)
+		}
+		child1, child2 := mate(newInd(), newInd())
+		if !b.IsFeasible(child1) || !b.IsFeasible(child2) {
+			t.Errorf("children aren't in bounds: %v %v", child1, child2)
+		}
+	}
+}
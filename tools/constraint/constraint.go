@@ -6,12 +6,42 @@ import (
 	"github.com/sineatos/deag/benchmarks"
 )
 
+// Constraint defines the functions of constraint for the individuals of any type, such as *base.Float64Individual, *base.IntIndividual and *base.BoolIndividual
+type Constraint interface {
+	// Evaluate returns the fitness values of individual with the constraint handled
+	Evaluate(individual base.Individual) []float64
+}
+
 // Float64Constraint defines the functions of constraint
 type Float64Constraint interface {
 	// AdjustAndEvolve checks individual and adjusts it if it is not feasible
 	AdjustAndEvolve(individual *base.Float64Individual) []float64
 }
 
+// IndividualFunc returns a vector of individual, it is the evaluator of the objectives or the violations of the constraints
+type IndividualFunc func(individual base.Individual) []float64
+
+// WrapFloat64 returns the IndividualFunc of f, such as a benchmarks.Float64Evaluator
+func WrapFloat64(f func(individual *base.Float64Individual) []float64) IndividualFunc {
+	return func(individual base.Individual) []float64 {
+		return f(individual.(*base.Float64Individual))
+	}
+}
+
+// WrapInt returns the IndividualFunc of f, such as a benchmarks.IntEvaluator
+func WrapInt(f func(individual *base.IntIndividual) []float64) IndividualFunc {
+	return func(individual base.Individual) []float64 {
+		return f(individual.(*base.IntIndividual))
+	}
+}
+
+// WrapBool returns the IndividualFunc of f, such as a benchmarks.BoolEvaluator
+func WrapBool(f func(individual *base.BoolIndividual) []float64) IndividualFunc {
+	return func(individual base.Individual) []float64 {
+		return f(individual.(*base.BoolIndividual))
+	}
+}
+
 // ClosestValidPenalty returns penalized fitness for invalid individuals and the original fitness value for valid individuals. The penalized fitness is made of the fitness of the closest valid individual added with a weighted (optional) distance penalty. The distance function, if provided, shall return a value growing as the individual moves away the valid zone.
 type ClosestValidPenalty struct {
 	// isFeasible checks individual if feasible
@@ -34,6 +64,11 @@ func NewClosestValidPenalty(isFeasible func(individual *base.Float64Individual)
 	}
 }
 
+// Evaluate is AdjustAndEvolve of Constraint, individual must be *base.Float64Individual
+func (con *ClosestValidPenalty) Evaluate(individual base.Individual) []float64 {
+	return con.AdjustAndEvolve(individual.(*base.Float64Individual))
+}
+
 // AdjustAndEvolve checks individual and adjusts it if it is not feasible
 func (con *ClosestValidPenalty) AdjustAndEvolve(individual *base.Float64Individual) []float64 {
 	if con.isFeasible(individual) {
@@ -41,15 +76,7 @@ func (con *ClosestValidPenalty) AdjustAndEvolve(individual *base.Float64Individu
 	}
 	fInd := con.adjust(individual)
 	fAns := con.evaluator(fInd)
-	fWeights := individual.GetFitness().GetWeights()
-	weights := make([]float64, len(fWeights))
-	for i := range fWeights {
-		if fWeights[i] >= 0.0 {
-			weights[i] = 1.0
-		} else {
-			weights[i] = -1.0
-		}
-	}
+	weights := directions(individual)
 	if len(weights) != len(fAns) {
 		panic(fmt.Sprintf("Fitness weights and computed fitness are of different size: %v,%v", weights, fAns))
 	}
@@ -2,6 +2,7 @@ package constraint
 
 import (
 	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/bounds"
 	"testing"
 )
 
@@ -67,3 +68,30 @@ func TestClosestValidPenalty(t *testing.T) {
 	// false
 	t.Log("Individuals is valid: ", valid(ind1))
 }
+
+func TestClosestValidPenaltyBounds(t *testing.T) {
+	b := bounds.NewBounds(0.0, 1.0, bounds.Clip, 2)
+	distance := func(fInd, oInd *base.Float64Individual) float64 {
+		fChrom := fInd.GetChromosome().([]float64)
+		oChrom := oInd.GetChromosome().([]float64)
+		ans := 0.0
+		for i, f := range fChrom {
+			ans += (f - oChrom[i]) * (f - oChrom[i])
+		}
+		return ans
+	}
+	sum := func(individual *base.Float64Individual) []float64 {
+		chrom := individual.GetChromosome().([]float64)
+		return []float64{chrom[0] + chrom[1]}
+	}
+	con := NewClosestValidPenalty(b.IsFeasible, b.Adjust, 2.0, distance, sum)
+
+	ind := base.NewFloat64Individual([]float64{0.5, 3.0}, base.NewFitness([]float64{-1.0}))
+	// the closest valid individual is [0.5, 1.0] at the distance 4, so the penalized fitness is 1.5 + 2 * 4
+	if ans := con.AdjustAndEvolve(ind); ans[0] != 9.5 {
+		t.Errorf("the out of bounds individual should be penalized to 9.5: %v", ans)
+	}
+	if chrom := ind.GetChromosome().([]float64); chrom[1] != 3.0 {
+		t.Errorf("the evaluation shouldn't modify the individual: %v", chrom)
+	}
+}
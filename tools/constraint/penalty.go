@@ -0,0 +1,250 @@
+package constraint
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/sineatos/deag/base"
+)
+
+// IsFeasible returns if all the violations are not positive
+func IsFeasible(violations []float64) bool {
+	for _, v := range violations {
+		if v > 0.0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SumViolation returns the sum of the positive violations, it can be used as the distance of NewDeltaPenalty
+func SumViolation(violations []float64) float64 {
+	return PowViolation(violations, 1.0)
+}
+
+// PowViolation returns the sum of v^beta of the positive violations v
+func PowViolation(violations []float64, beta float64) float64 {
+	ans := 0.0
+	for _, v := range violations {
+		if v > 0.0 {
+			ans += math.Pow(v, beta)
+		}
+	}
+	return ans
+}
+
+// directions returns 1 for the objectives of individual to be maximized and -1 for the objectives to be minimized
+func directions(individual base.Individual) []float64 {
+	fWeights := individual.GetFitness().GetWeights()
+	weights := make([]float64, len(fWeights))
+	for i := range fWeights {
+		if fWeights[i] >= 0.0 {
+			weights[i] = 1.0
+		} else {
+			weights[i] = -1.0
+		}
+	}
+	return weights
+}
+
+// penalize returns values worsened by penalty in the directions of the objectives of individual
+func penalize(individual base.Individual, values []float64, penalty float64) []float64 {
+	weights := directions(individual)
+	if len(weights) != len(values) {
+		panic(fmt.Sprintf("Fitness weights and computed fitness are of different size: %v,%v", weights, values))
+	}
+	ans := make([]float64, len(values))
+	for i := range ans {
+		ans[i] = values[i] - weights[i]*penalty
+	}
+	return ans
+}
+
+// DeltaPenalty returns the constant delta for the infeasible individuals and the original fitness values for the feasible individuals.
+// The constant delta should be worse than the fitness of any feasible individual, it can be moved away by the distance of the violations
+// to guide the search toward the feasible region.
+type DeltaPenalty struct {
+	evaluator  IndividualFunc
+	violations IndividualFunc
+	delta      []float64
+	distance   func(violations []float64) float64
+}
+
+// NewDeltaPenalty returns *DeltaPenalty. violations returns the violations of the constraints of individual, an individual is feasible if none of them is positive.
+// distance returns the distance to the feasible region for the violations, such as SumViolation, it can be nil.
+func NewDeltaPenalty(evaluator, violations IndividualFunc, delta []float64, distance func(violations []float64) float64) *DeltaPenalty {
+	return &DeltaPenalty{evaluator: evaluator, violations: violations, delta: delta, distance: distance}
+}
+
+// Evaluate returns the fitness values of individual, see DeltaPenalty
+func (con *DeltaPenalty) Evaluate(individual base.Individual) []float64 {
+	violations := con.violations(individual)
+	if IsFeasible(violations) {
+		return con.evaluator(individual)
+	}
+	d := 0.0
+	if con.distance != nil {
+		d = con.distance(violations)
+	}
+	return penalize(individual, con.delta, d)
+}
+
+// StaticPenalty adds the penalty sum_i r_i * v_i^beta of the positive violations v_i with the fixed coefficients r_i to the fitness values of the infeasible individuals
+type StaticPenalty struct {
+	evaluator    IndividualFunc
+	violations   IndividualFunc
+	coefficients []float64
+	beta         float64
+}
+
+// NewStaticPenalty returns *StaticPenalty, coefficients are the r_i of the constraints, or one coefficient used by all constraints.
+// beta is usually 1 or 2.
+func NewStaticPenalty(evaluator, violations IndividualFunc, coefficients []float64, beta float64) *StaticPenalty {
+	if len(coefficients) == 0 {
+		panic("The coefficients of the static penalty should be given")
+	}
+	return &StaticPenalty{evaluator: evaluator, violations: violations, coefficients: coefficients, beta: beta}
+}
+
+// Penalty returns the penalty of the violations
+func (con *StaticPenalty) Penalty(violations []float64) float64 {
+	if len(con.coefficients) != 1 && len(con.coefficients) != len(violations) {
+		panic(fmt.Sprintf("The coefficients should be given for %d constraints: %v", len(violations), con.coefficients))
+	}
+	ans := 0.0
+	for i, v := range violations {
+		if v > 0.0 {
+			r := con.coefficients[0]
+			if len(con.coefficients) > 1 {
+				r = con.coefficients[i]
+			}
+			ans += r * math.Pow(v, con.beta)
+		}
+	}
+	return ans
+}
+
+// Evaluate returns the penalized fitness values of individual
+func (con *StaticPenalty) Evaluate(individual base.Individual) []float64 {
+	violations := con.violations(individual)
+	values := con.evaluator(individual)
+	if IsFeasible(violations) {
+		return values
+	}
+	return penalize(individual, values, con.Penalty(violations))
+}
+
+// DynamicPenalty adds the penalty (C * t)^alpha * sum_i v_i^beta of the positive violations v_i to the fitness values of the infeasible individuals,
+// which grows with the generation t.
+//
+// From: J. A. Joines and C. R. Houck. On the use of non-stationary penalty functions to solve nonlinear constrained optimization problems with GA's. IEEE World Congress on Computational Intelligence, p. 579 - 584, 1994.
+type DynamicPenalty struct {
+	evaluator  IndividualFunc
+	violations IndividualFunc
+	c          float64
+	alpha      float64
+	beta       float64
+	generation int
+}
+
+// NewDynamicPenalty returns *DynamicPenalty at the generation 1, the authors suggest C = 0.5 and alpha = beta = 2
+func NewDynamicPenalty(evaluator, violations IndividualFunc, c, alpha, beta float64) *DynamicPenalty {
+	return &DynamicPenalty{evaluator: evaluator, violations: violations, c: c, alpha: alpha, beta: beta, generation: 1}
+}
+
+// GetGeneration returns the current generation
+func (con *DynamicPenalty) GetGeneration() int {
+	return con.generation
+}
+
+// SetGeneration sets the current generation, which should be called by the algorithm before each generation is evaluated
+func (con *DynamicPenalty) SetGeneration(generation int) {
+	con.generation = generation
+}
+
+// NextGeneration increases the current generation by 1
+func (con *DynamicPenalty) NextGeneration() {
+	con.generation++
+}
+
+// Penalty returns the penalty of the violations at the current generation
+func (con *DynamicPenalty) Penalty(violations []float64) float64 {
+	return math.Pow(con.c*float64(con.generation), con.alpha) * PowViolation(violations, con.beta)
+}
+
+// Evaluate returns the penalized fitness values of individual
+func (con *DynamicPenalty) Evaluate(individual base.Individual) []float64 {
+	violations := con.violations(individual)
+	values := con.evaluator(individual)
+	if IsFeasible(violations) {
+		return values
+	}
+	return penalize(individual, values, con.Penalty(violations))
+}
+
+// AdaptivePenalty adds the penalty lambda * sum_i v_i^2 of the positive violations v_i to the fitness values of the infeasible individuals,
+// lambda is adapted by the feasibility of the best individuals of the last k generations:
+// it is divided by beta1 if all of them are feasible, multiplied by beta2 if all of them are infeasible and unchanged otherwise.
+//
+// From: A. Ben Hadj-Alouane and J. C. Bean. A genetic algorithm for the multiple-choice integer program. Operations Research, vol. 45, no. 1, p. 92 - 101, 1997.
+type AdaptivePenalty struct {
+	evaluator  IndividualFunc
+	violations IndividualFunc
+	lambda     float64
+	beta1      float64
+	beta2      float64
+	k          int
+	history    []bool // the feasibility of the best individuals of the last k generations
+}
+
+// NewAdaptivePenalty returns *AdaptivePenalty with the initial lambda, beta1 and beta2 should be greater than 1 and different from each other
+func NewAdaptivePenalty(evaluator, violations IndividualFunc, lambda, beta1, beta2 float64, k int) *AdaptivePenalty {
+	if beta1 <= 1.0 || beta2 <= 1.0 || beta1 == beta2 {
+		panic(fmt.Sprintf("beta1 and beta2 should be different and greater than 1: %v, %v", beta1, beta2))
+	}
+	if k < 1 {
+		panic(fmt.Sprintf("k should be positive: %v", k))
+	}
+	return &AdaptivePenalty{evaluator: evaluator, violations: violations, lambda: lambda, beta1: beta1, beta2: beta2, k: k}
+}
+
+// GetLambda returns the current lambda
+func (con *AdaptivePenalty) GetLambda() float64 {
+	return con.lambda
+}
+
+// Update records the feasibility of the best individual of the generation and adapts lambda, which should be called by the algorithm after each generation
+func (con *AdaptivePenalty) Update(best base.Individual) {
+	con.history = append(con.history, IsFeasible(con.violations(best)))
+	if len(con.history) > con.k {
+		con.history = con.history[1:]
+	}
+	if len(con.history) < con.k {
+		return
+	}
+	feasible, infeasible := true, true
+	for _, f := range con.history {
+		feasible = feasible && f
+		infeasible = infeasible && !f
+	}
+	if feasible {
+		con.lambda /= con.beta1
+	} else if infeasible {
+		con.lambda *= con.beta2
+	}
+}
+
+// Penalty returns the penalty of the violations with the current lambda
+func (con *AdaptivePenalty) Penalty(violations []float64) float64 {
+	return con.lambda * PowViolation(violations, 2.0)
+}
+
+// Evaluate returns the penalized fitness values of individual
+func (con *AdaptivePenalty) Evaluate(individual base.Individual) []float64 {
+	violations := con.violations(individual)
+	values := con.evaluator(individual)
+	if IsFeasible(violations) {
+		return values
+	}
+	return penalize(individual, values, con.Penalty(violations))
+}
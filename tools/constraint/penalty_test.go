@@ -0,0 +1,92 @@
+package constraint
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/benchmarks"
+)
+
+func TestDeltaPenalty(t *testing.T) {
+	ks := benchmarks.NewSingleKnapsack([]float64{3, 4, 5}, []float64{4, 5, 6}, 8)
+	violations := WrapBool(func(individual *base.BoolIndividual) []float64 {
+		return ks.Violations(individual.GetChromosome().([]bool))
+	})
+	var con Constraint = NewDeltaPenalty(WrapBool(ks.Evaluate), violations, []float64{0.0}, SumViolation)
+	feasible := base.NewBoolIndividual([]bool{true, false, true}, base.NewFitness([]float64{1.0}))
+	if v := con.Evaluate(feasible); v[0] != 10.0 {
+		t.Errorf("The feasible individual should have its profit 10: %v", v)
+	}
+	// the weight 12 exceeds the capacity 8 by 4, which is subtracted from delta for maximization
+	infeasible := base.NewBoolIndividual([]bool{true, true, true}, base.NewFitness([]float64{1.0}))
+	if v := con.Evaluate(infeasible); v[0] != -4.0 {
+		t.Errorf("The infeasible individual should have delta - 4: %v", v)
+	}
+}
+
+func TestStaticAndDynamicPenalty(t *testing.T) {
+	cp := benchmarks.GetConstrainedProblem("g06")
+	evaluator, violations := WrapFloat64(cp.Evaluate), WrapFloat64(cp.EvaluateViolations)
+	optimum := base.NewFloat64Individual(cp.GetOptimizer(), base.NewFitness([]float64{-1.0}))
+	static := NewStaticPenalty(evaluator, violations, []float64{1000.0}, 2.0)
+	if v := static.Evaluate(optimum); math.Abs(v[0]-cp.GetOptimum()) > 1e-3 {
+		t.Errorf("The optimum of g06 shouldn't be penalized: %v", v)
+	}
+	x := []float64{50.0, 50.0}
+	infeasible := base.NewFloat64Individual(x, base.NewFitness([]float64{-1.0}))
+	expected := cp.Objective(x) + 1000.0*PowViolation(cp.Violations(x), 2.0)
+	if v := static.Evaluate(infeasible); math.Abs(v[0]-expected) > 1e-6 || v[0] <= cp.Objective(x) {
+		t.Errorf("The infeasible individual should be penalized to %v: %v", expected, v)
+	}
+
+	dynamic := NewDynamicPenalty(evaluator, violations, 0.5, 2.0, 2.0)
+	v1 := dynamic.Evaluate(infeasible)[0]
+	dynamic.SetGeneration(10)
+	v10 := dynamic.Evaluate(infeasible)[0]
+	if v10 <= v1 {
+		t.Errorf("The dynamic penalty should grow with the generation: %v, %v", v1, v10)
+	}
+	if math.Abs((v10-cp.Objective(x))/(v1-cp.Objective(x))-100.0) > 1e-6 {
+		t.Errorf("The dynamic penalty should be proportional to (C * t)^2: %v, %v", v1, v10)
+	}
+}
+
+func TestAdaptivePenalty(t *testing.T) {
+	// the sum of the genes should be at most 10
+	violations := WrapInt(func(individual *base.IntIndividual) []float64 {
+		sum := 0
+		for _, g := range individual.GetChromosome().([]int) {
+			sum += g
+		}
+		return []float64{math.Max(0.0, float64(sum-10))}
+	})
+	evaluator := WrapInt(func(individual *base.IntIndividual) []float64 {
+		return []float64{float64(individual.Len())}
+	})
+	con := NewAdaptivePenalty(evaluator, violations, 1.0, 2.0, 4.0, 2)
+	feasible := base.NewIntIndividual([]int{1, 2, 3}, base.NewFitness([]float64{1.0}))
+	infeasible := base.NewIntIndividual([]int{5, 5, 3}, base.NewFitness([]float64{1.0}))
+	if v := con.Evaluate(infeasible); v[0] != 3.0-9.0 {
+		t.Errorf("The penalty should be lambda * 3^2: %v", v)
+	}
+	con.Update(infeasible)
+	if con.GetLambda() != 1.0 {
+		t.Errorf("lambda shouldn't change before k generations: %v", con.GetLambda())
+	}
+	con.Update(infeasible)
+	if con.GetLambda() != 4.0 {
+		t.Errorf("lambda should be multiplied by beta2: %v", con.GetLambda())
+	}
+	con.Update(feasible)
+	if con.GetLambda() != 4.0 {
+		t.Errorf("lambda shouldn't change for the mixed feasibility: %v", con.GetLambda())
+	}
+	con.Update(feasible)
+	if con.GetLambda() != 2.0 {
+		t.Errorf("lambda should be divided by beta1: %v", con.GetLambda())
+	}
+	if v := con.Evaluate(feasible); v[0] != 3.0 {
+		t.Errorf("The feasible individual shouldn't be penalized: %v", v)
+	}
+}
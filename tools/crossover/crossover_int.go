@@ -147,7 +147,7 @@ func CxPartialyMatched(ind1 *base.IntIndividual, ind2 *base.IntIndividual) (*bas
 		temp1, temp2 := c1[i], c2[i]
 		// Swap the matched value
 		c1[i], c1[p1[temp2]] = temp2, temp1
-		c2[i], c1[p2[temp1]] = temp1, temp2
+		c2[i], c2[p2[temp1]] = temp1, temp2
 		// Position bookkeeping
 		p1[temp1], p1[temp2] = p1[temp2], p1[temp1]
 		p2[temp1], p2[temp2] = p2[temp2], p2[temp1]
@@ -189,7 +189,7 @@ func CxUniformPartialyMatched(ind1 *base.IntIndividual, ind2 *base.IntIndividual
 			temp1, temp2 := c1[i], c2[i]
 			// Swap the matched value
 			c1[i], c1[p1[temp2]] = temp2, temp1
-			c2[i], c1[p2[temp1]] = temp1, temp2
+			c2[i], c2[p2[temp1]] = temp1, temp2
 			// Position bookkeeping
 			p1[temp1], p1[temp2] = p1[temp2], p1[temp1]
 			p2[temp1], p2[temp2] = p2[temp2], p2[temp1]
@@ -227,11 +227,13 @@ func CxOrdered(ind1 *base.IntIndividual, ind2 *base.IntIndividual) (*base.IntInd
 	if a > b {
 		a, b = b, a
 	}
+	// The values out of [a, b] of the other individual aren't holes
+	for i := 0; i < size; i++ {
+		holes1[i], holes2[i] = true, true
+	}
 	for i := 0; i < size; i++ {
 		if i < a || i > b {
-			holes1[i], holes2[i] = false, false
-		} else {
-			holes1[i], holes2[i] = true, true
+			holes1[c2[i]], holes2[c1[i]] = false, false
 		}
 	}
 	// We must keep the original values somewhere before scrambling everything
@@ -151,3 +151,25 @@ func TestCxESTwoPointInt(t *testing.T) {
 		t.Errorf("ind2 is not equal to ind4: %v %v", ind2, ind4)
 	}
 }
+
+func TestPermutationCrossovers(t *testing.T) {
+	isPermutation := func(ind *base.IntIndividual) bool {
+		visited := make([]bool, idims)
+		for _, v := range ind.GetChromosome().([]int) {
+			if visited[v] {
+				return false
+			}
+			visited[v] = true
+		}
+		return true
+	}
+	for k := 0; k < 100; k++ {
+		ind1, ind2 := base.NewIntIndividual(rand.Perm(idims), generateFitness()), base.NewIntIndividual(rand.Perm(idims), generateFitness())
+		CxPartialyMatched(ind1, ind2)
+		CxUniformPartialyMatched(ind1, ind2, 0.5)
+		CxOrdered(ind1, ind2)
+		if !isPermutation(ind1) || !isPermutation(ind2) {
+			t.Fatalf("the offspring should be permutations: %v %v", ind1, ind2)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package dynamic
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/support"
+)
+
+const (
+	// CHANGES is the name of statistics which is the number of the detected changes
+	CHANGES string = "changes"
+	// OFFLINEERROR is the name of statistics which is the offline error
+	OFFLINEERROR string = "offline_error"
+	// CURRENTERROR is the name of statistics which is the current error
+	CURRENTERROR string = "current_error"
+)
+
+// Evaluate returns the fitness values of the individual in the current environment
+type Evaluate func(ind base.Individual) []float64
+
+// Response reacts to a change of the environment by modifying the population in place,
+// the fitness values of the population are those before the change, and the population is re-evaluated by the algorithm after the responses.
+type Response func(population base.Individuals)
+
+// Handler handles the dynamic optimization for an algorithm.
+// The changes of the environment are detected by re-evaluating the sentinels, which are the copies of some individuals kept since the last change.
+// A change is detected if the fitness values of any sentinel are different from the saved ones.
+// When a change is detected, the algorithm calls Respond and re-evaluates the population and its memories, such as the pbests of PSO and the HallOfFame.
+type Handler struct {
+	sentinels int
+	responses []Response
+	watched   base.Individuals
+	values    [][]float64
+	changes   int
+	offline   func() float64
+	current   func() float64
+}
+
+// NewHandler returns *Handler, sentinels is the number of the sentinels re-evaluated in each generation, responses are applied in order when a change is detected
+func NewHandler(sentinels int, responses ...Response) *Handler {
+	if sentinels < 1 {
+		panic(fmt.Sprintf("The number of sentinels should be positive: %d", sentinels))
+	}
+	return &Handler{sentinels: sentinels, responses: responses}
+}
+
+// SetErrors sets the functions returning the offline error and the current error, which are logged with the names OFFLINEERROR and CURRENTERROR,
+// such as SetErrors(mp.OfflineError, mp.CurrentError) for benchmarks.MovingPeaks. Either of them can be nil.
+func (h *Handler) SetErrors(offline, current func() float64) {
+	h.offline, h.current = offline, current
+}
+
+// GetChanges returns the number of the detected changes
+func (h *Handler) GetChanges() int {
+	return h.changes
+}
+
+// Reset clears the detected changes and the sentinels, it is called by the algorithm at initialization
+func (h *Handler) Reset() {
+	h.changes = 0
+	h.watched, h.values = nil, nil
+}
+
+// Watch randomly chooses the sentinels from the evaluated population
+func (h *Handler) Watch(population base.Individuals) {
+	n := h.sentinels
+	if n > population.Len() {
+		n = population.Len()
+	}
+	h.watched, h.values = make(base.Individuals, n), make([][]float64, n)
+	for k, i := range rand.Perm(population.Len())[:n] {
+		h.watched[k] = population[i].Clone().(base.Individual)
+		h.values[k] = copyValues(population[i].GetFitness().GetValues())
+	}
+}
+
+// Detect re-evaluates the sentinels by evaluate and returns if the environment is changed and the number of evaluations
+func (h *Handler) Detect(evaluate Evaluate) (bool, int) {
+	changed := false
+	for k, ind := range h.watched {
+		values := evaluate(ind)
+		for j, v := range values {
+			if j >= len(h.values[k]) || v != h.values[k][j] {
+				changed = true
+			}
+		}
+		h.values[k] = values
+	}
+	return changed, len(h.watched)
+}
+
+// Respond applies the responses to population and counts the change
+func (h *Handler) Respond(population base.Individuals) {
+	for _, response := range h.responses {
+		response(population)
+	}
+	h.changes++
+}
+
+// Record records the number of the changes and the errors into datas
+func (h *Handler) Record(datas support.Dict) {
+	datas[CHANGES] = h.changes
+	if h.offline != nil {
+		datas[OFFLINEERROR] = h.offline()
+	}
+	if h.current != nil {
+		datas[CURRENTERROR] = h.current()
+	}
+}
+
+// Reevaluate re-evaluates the individuals by evaluate and returns the number of evaluations
+func Reevaluate(individuals base.Individuals, evaluate Evaluate) int {
+	for _, ind := range individuals {
+		ind.GetFitness().SetValues(evaluate(ind))
+	}
+	return individuals.Len()
+}
+
+// ReevaluateHallOfFame re-evaluates the individuals in hof, then rebuilds hof with them and population (which should have been re-evaluated).
+// It returns the number of evaluations.
+func ReevaluateHallOfFame(hof support.HallOfFame, population base.Individuals, evaluate Evaluate) int {
+	items := make(base.Individuals, hof.Len())
+	for i := range items {
+		items[i] = hof.Get(i)
+	}
+	fes := Reevaluate(items, evaluate)
+	hof.Clear()
+	hof.Update(append(items, population...))
+	return fes
+}
+
+// copyValues returns a copy of values
+func copyValues(values []float64) []float64 {
+	ans := make([]float64, len(values))
+	copy(ans, values)
+	return ans
+}
@@ -0,0 +1,89 @@
+package dynamic
+
+import (
+	"testing"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/bounds"
+	"github.com/sineatos/deag/tools/support"
+)
+
+// newPopulation returns the population whose i-th individual is [i, i] with the fitness i
+func newPopulation(size int) base.Individuals {
+	pop := make(base.Individuals, size)
+	for i := range pop {
+		pop[i] = base.NewFloat64Individual([]float64{float64(i), float64(i)}, base.NewFitnessWithValues([]float64{1.0}, []float64{float64(i)}))
+	}
+	return pop
+}
+
+func TestHandler(t *testing.T) {
+	shift := 0.0
+	evaluate := func(ind base.Individual) []float64 {
+		return []float64{ind.GetChromosome().([]float64)[0] + shift}
+	}
+	pop := newPopulation(10)
+	h := NewHandler(3)
+	h.SetErrors(func() float64 { return 1.0 }, nil)
+	h.Watch(pop)
+	if changed, fes := h.Detect(evaluate); changed || fes != 3 {
+		t.Errorf("no change should be detected with 3 evaluations: %v, %v", changed, fes)
+	}
+	shift = 5.0
+	if changed, _ := h.Detect(evaluate); !changed {
+		t.Error("the change should be detected")
+	}
+	h.Respond(pop)
+	if fes := Reevaluate(pop, evaluate); fes != 10 || pop[0].GetFitness().GetValues()[0] != 5.0 {
+		t.Errorf("the population should be re-evaluated: %v, %v", fes, pop[0])
+	}
+	hof := support.NewDefaultHallOfFame(2, nil)
+	hof.Update(newPopulation(10))
+	if fes := ReevaluateHallOfFame(hof, pop, evaluate); fes != 2 || hof.Get(0).GetFitness().GetValues()[0] != 14.0 {
+		t.Errorf("the HallOfFame should be re-evaluated: %v, %v", fes, hof)
+	}
+	datas := make(support.Dict)
+	h.Record(datas)
+	if datas[CHANGES] != 1 || datas[OFFLINEERROR] != 1.0 {
+		t.Errorf("wrong records: %v", datas)
+	}
+	if _, ok := datas[CURRENTERROR]; ok {
+		t.Errorf("the current error shouldn't be recorded: %v", datas)
+	}
+}
+
+func TestResponses(t *testing.T) {
+	b := bounds.NewBounds(-100.0, 100.0, bounds.Clip, 2)
+	pop := newPopulation(10)
+	PartialReinit(0.3, b)(pop)
+	moved := 0
+	for i, ind := range pop {
+		if x := ind.GetChromosome().([]float64); x[0] != float64(i) {
+			moved++
+		}
+	}
+	if moved != 3 {
+		t.Errorf("3 individuals should be reinitialized: %v", moved)
+	}
+	pop = newPopulation(10)
+	Hypermutation(10.0, 1.0, b)(pop)
+	for _, ind := range pop {
+		if !b.Contains(ind.GetChromosome().([]float64)) {
+			t.Errorf("the individual is out of bounds: %v", ind)
+		}
+	}
+	memory := Memory(2)
+	memory(newPopulation(10))
+	pop = newPopulation(10)
+	pop[9].SetChromosome([]float64{50.0, 50.0})
+	memory(pop)
+	// the best of the first population [9, 9] replaces the worst individual
+	if x := pop[0].GetChromosome().([]float64); x[0] != 9.0 {
+		t.Errorf("the memory should replace the worst individual: %v", pop)
+	}
+	pop = newPopulation(10)
+	memory(pop)
+	if x0, x1 := pop[0].GetChromosome().([]float64), pop[1].GetChromosome().([]float64); x0[0] != 9.0 || x1[0] != 50.0 {
+		t.Errorf("the memory should replace the two worst individuals: %v", pop)
+	}
+}
@@ -0,0 +1,71 @@
+package dynamic
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/sineatos/deag/base"
+	"github.com/sineatos/deag/tools/bounds"
+)
+
+// PartialReinit returns the Response which moves the ratio of the randomly chosen individuals to random positions in b,
+// the individuals should have []float64 chromosomes.
+func PartialReinit(ratio float64, b *bounds.Bounds) Response {
+	return func(population base.Individuals) {
+		n := int(ratio*float64(population.Len()) + 0.5)
+		for _, i := range rand.Perm(population.Len())[:n] {
+			x := make([]float64, b.Len())
+			for j := range x {
+				x[j] = b.Low(j) + rand.Float64()*(b.Up(j)-b.Low(j))
+			}
+			population[i].SetChromosome(x)
+		}
+	}
+}
+
+// Hypermutation returns the Response which adds the Gaussian noise to each component with the probability indpb [Cobb1990],
+// the standard deviation is sigma times the width of b in the dimension, and the components out of b are repaired by b.
+// The individuals should have []float64 chromosomes.
+//
+// [Cobb1990] Cobb, "An investigation into the use of hypermutation as an adaptive operator in genetic algorithms having continuous, time-dependent nonstationary environments", 1990.
+func Hypermutation(sigma, indpb float64, b *bounds.Bounds) Response {
+	return func(population base.Individuals) {
+		for _, ind := range population {
+			parent := ind.GetChromosome().([]float64)
+			x := make([]float64, len(parent))
+			for j, v := range parent {
+				x[j] = v
+				if rand.Float64() < indpb {
+					x[j] += rand.NormFloat64() * sigma * (b.Up(j) - b.Low(j))
+				}
+			}
+			ind.SetChromosome(b.Repair(x, parent))
+		}
+	}
+}
+
+// Memory returns the Response using an explicit memory of size solutions [Branke1999].
+// When a change is detected, the best individual of the population (in the old environment) is saved into the memory replacing the oldest one,
+// and the other solutions in the memory are copied to the worst individuals of the population. The chromosomes are copied by SetChromosome.
+//
+// [Branke1999] Branke, "Memory enhanced evolutionary algorithms for changing optimization problems", 1999.
+func Memory(size int) Response {
+	memory := make([]interface{}, 0, size)
+	return func(population base.Individuals) {
+		ranked := make(base.Individuals, population.Len())
+		copy(ranked, population)
+		sort.Stable(sort.Reverse(ranked))
+		for k, chrom := range memory {
+			if k >= ranked.Len()-1 {
+				break
+			}
+			ranked[ranked.Len()-1-k].SetChromosome(chrom)
+		}
+		best := ranked[0].Clone().(base.Individual).GetChromosome()
+		if len(memory) < size {
+			memory = append(memory, best)
+		} else if size > 0 {
+			memory = append(memory[1:], best)
+		}
+	}
+}
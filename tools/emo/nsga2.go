@@ -83,23 +83,25 @@ func sortNondominated(individuals base.Individuals, k int, firstFrontOnly bool)
 	fits := uniqueByFitness(individuals)
 
 	cap := len(individuals)
-	currentFront := make([]*base.Fitness, 0, cap)
-	nextFront := make([]*base.Fitness, 0, cap)
-	dominatingFits := make(map[*base.Fitness]int)
-	dominatedFits := list.NewStaticLinkedList(cap * (cap - 1) / 2)
-
+	// dominatingCounts[i] is the number of fitnesses dominating fits[i], and dominatedFits[i] saves the indexes of fitnesses dominated by fits[i]
+	dominatingCounts := make([]int, len(fits))
+	dominatedFits := make([][]int, len(fits))
 	for i, fitI := range fits {
-		for j, fitJ := range fits[i+1:] {
+		for j := i + 1; j < len(fits); j++ {
+			fitJ := fits[j]
 			if fitI.Dominates(fitJ, nil) {
-				dominatingFits[fitJ]++
-				dominatedFits.Add(fitI, j)
+				dominatingCounts[j]++
+				dominatedFits[i] = append(dominatedFits[i], j)
 			} else if fitJ.Dominates(fitI, nil) {
-				dominatingFits[fitI]++
-				dominatedFits.Add(fitJ, i)
+				dominatingCounts[i]++
+				dominatedFits[j] = append(dominatedFits[j], i)
 			}
 		}
-		if dominatingFits[fitI] == 0 {
-			currentFront = append(currentFront, fitI)
+	}
+	currentFront := make([]int, 0, len(fits))
+	for i, count := range dominatingCounts {
+		if count == 0 {
+			currentFront = append(currentFront, i)
 		}
 	}
 
@@ -117,35 +119,29 @@ func sortNondominated(individuals base.Individuals, k int, firstFrontOnly bool)
 		}
 	}
 
-	for _, fit := range currentFront {
-		extends(fit)
+	for _, i := range currentFront {
+		extends(fits[i])
 	}
 	paretoSorted, _ := fronts.GetSize(frontIndex)
 
 	// Rank the next front until all individuals are sorted or the given number of individual are sorted.
 	if !firstFrontOnly {
 		N := utility.If(cap < k, cap, k).(int)
-		frontIndex++
-		for paretoSorted < N {
-			for _, fitP := range currentFront {
-				next, exist := dominatedFits.GetFirstDataIndex(fitP)
-				if exist {
-					fitDIdx, next := dominatedFits.GetData(next)
-					for next != -1 {
-						fitD := fits[fitDIdx]
-						dominatingFits[fitD]--
-						if dominatingFits[fitD] == 0 {
-							nextFront = append(nextFront, fitD)
-							fSize, _ := mapFitInd.GetSize(fitD)
-							paretoSorted += fSize
-							extends(fitD)
-						}
-						fitDIdx, next = dominatedFits.GetData(next)
+		for paretoSorted < N && len(currentFront) > 0 {
+			frontIndex++
+			nextFront := make([]int, 0, len(fits))
+			for _, i := range currentFront {
+				for _, j := range dominatedFits[i] {
+					dominatingCounts[j]--
+					if dominatingCounts[j] == 0 {
+						nextFront = append(nextFront, j)
+						fSize, _ := mapFitInd.GetSize(fits[j])
+						paretoSorted += fSize
+						extends(fits[j])
 					}
 				}
 			}
-			currentFront, nextFront = nextFront, currentFront
-			nextFront = nextFront[0:0]
+			currentFront = nextFront
 		}
 	}
 
@@ -215,6 +211,12 @@ func staticLinkedList2Slice(fronts *list.StaticLinkedList, individuals base.Indi
 	return ans
 }
 
+// CrowdingDistance returns the crowding distance of each individual in individuals, which are usually in the same Pareto front.
+// The boundary individuals of each objective have infinite distances.
+func CrowdingDistance(individuals base.Individuals) []float64 {
+	return assignCrowdingDist(individuals)
+}
+
 // assignCrowdingDist assigns a crowding distance to each individual's fitness, and returns a slice of float64 which is the crowding distance of individual's fitness.
 func assignCrowdingDist(individuals base.Individuals) []float64 {
 	popSize := len(individuals)
@@ -93,3 +93,30 @@ func TestNSGA2(t *testing.T) {
 
 	t.Log(logbook.String())
 }
+
+func TestSortNondominated(t *testing.T) {
+	// the fronts are {(0,3), (1,1), (3,0)}, {(1,3), (2,2), (2,2)} and {(3,3)}
+	values := [][]float64{{3, 3}, {2, 2}, {0, 3}, {1, 3}, {1, 1}, {3, 0}, {2, 2}}
+	ranks := []int{2, 1, 0, 1, 0, 0, 1}
+	inds := make(base.Individuals, len(values))
+	for i, v := range values {
+		fit := base.NewFitness([]float64{-1.0, -1.0})
+		fit.SetValues(v)
+		inds[i] = base.NewFloat64Individual([]float64{float64(i)}, fit)
+	}
+	fronts := sortNondominated(inds, len(inds), false)
+	if len(fronts) != 3 {
+		t.Fatalf("the number of fronts should be 3: %v", fronts)
+	}
+	for rank, front := range fronts {
+		for _, ind := range front {
+			i := int(ind.GetChromosome().([]float64)[0])
+			if ranks[i] != rank {
+				t.Errorf("%v should be in front %d, not %d", values[i], ranks[i], rank)
+			}
+		}
+	}
+	if chosen := SelNSGA2(inds, 4); chosen.Len() != 4 {
+		t.Errorf("SelNSGA2 should select 4 individuals: %v", chosen)
+	}
+}
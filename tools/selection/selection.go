@@ -85,7 +85,7 @@ func SelRoulette(individuals base.Individuals, k int) base.Individuals {
 // In GP, it has been shown that this operator produces better results when it is combined with some kind of a depth limit.
 //
 // [Luke2002fighting] Luke and Panait, 2002, Fighting bloat with nonparametric parsimony pressure
-func SelDoubleTournament(individuals base.Individuals, k int, fitnessSize, parsimonySize int, fitnessFirst bool) base.Individuals {
+func SelDoubleTournament(individuals base.Individuals, k int, fitnessSize int, parsimonySize float64, fitnessFirst bool) base.Individuals {
 	if !(1 <= parsimonySize && parsimonySize <= 2) {
 		panic("Parsimony tournament size has to be in the range [1, 2].")
 	}
@@ -93,9 +93,8 @@ func SelDoubleTournament(individuals base.Individuals, k int, fitnessSize, parsi
 	sizeTournament := func(inds base.Individuals, kk int, selectFunc func(base.Individuals, int) base.Individuals) base.Individuals {
 		chosen := make(base.Individuals, kk)
 		var prob float64
-		parsimonySizef64 := float64(parsimonySize)
 		for i := 0; i < kk; i++ {
-			prob = parsimonySizef64 / 2.0
+			prob = parsimonySize / 2.0
 			tmp := selectFunc(inds, 2)
 			ind1, ind2 := tmp[0], tmp[1]
 			if ind1.Len() > ind2.Len() {
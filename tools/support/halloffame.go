@@ -39,6 +39,7 @@ type DefaultHallOfFame struct {
 
 // NewDefaultHallOfFame returns a DefaultHallOfFame setting with maxsize and similar.
 // If similar is nil, sets the similar as
+//
 //	func(x, y base.Individual) bool { x.IsEqual(y) }
 func NewDefaultHallOfFame(maxsize int, similar func(base.Individual, base.Individual) bool) *DefaultHallOfFame {
 	if similar == nil {
@@ -59,7 +60,7 @@ func (hof *DefaultHallOfFame) Update(individuals base.Individuals) {
 	for _, ind := range individuals {
 		if ind.GetFitness().Greater(hof.items[hof.size-1].GetFitness()) || hof.size < hof.maxsize {
 			flag := true
-			for _, hofer := range hof.items {
+			for _, hofer := range hof.items[:hof.size] {
 				if hof.similar(ind, hofer) {
 					flag = false
 					break
@@ -57,4 +57,9 @@ func TestDefaultHallOfFame(t *testing.T) {
 	t.Log("Clear() ---------------------------------------------------")
 	hof1.Clear()
 	t.Log(hof1)
+	// the removed individuals can be inserted again after Clear
+	hof1.Update(base.Individuals{ind3, ind2})
+	if hof1.Len() != 2 {
+		t.Errorf("hof1's len != 2 after Clear and Update: %v", hof1.Len())
+	}
 }